@@ -0,0 +1,86 @@
+// Package bytecode 校验链上合约代码是否由给定的编译产物部署。
+//
+// solc 在运行时字节码末尾附加 CBOR 编码的元数据（包含源码哈希，注释或路径变化都会改变它），
+// 最后两个字节是元数据长度；immutable 变量的值在部署时才写入代码。比较时去掉元数据，
+// 并忽略编译器报告的 immutable 位置，其余字节必须完全相同。
+package bytecode
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrNoCode 表示地址上没有合约代码
+	ErrNoCode = errors.New("no contract code at address")
+	// ErrMismatch 表示链上代码与编译产物不一致
+	ErrMismatch = errors.New("contract code does not match artifact")
+)
+
+// Range 是代码中的一段字节，用于标记 immutable 变量的位置
+type Range struct {
+	Start  int
+	Length int
+}
+
+// Runtime 是合约的运行时字节码和其中 immutable 变量的位置
+type Runtime struct {
+	Code       []byte
+	Immutables []Range
+}
+
+// StripMetadata 去掉末尾的 CBOR 元数据。末尾长度不合理（没有元数据）时原样返回。
+func StripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(binary.BigEndian.Uint16(code[len(code)-2:]))
+	// CBOR 映射以 0xa0-0xb7 开头
+	if n == 0 || n+2 > len(code) || code[len(code)-2-n]&0xe0 != 0xa0 {
+		return code
+	}
+	return code[:len(code)-2-n]
+}
+
+// Match 比较链上代码 code 与 r，不一致时返回包装 ErrMismatch 的错误，说明第一个不同的位置。
+func (r *Runtime) Match(code []byte) error {
+	if len(code) == 0 {
+		return ErrNoCode
+	}
+	want, got := StripMetadata(r.Code), StripMetadata(code)
+	if len(want) != len(got) {
+		return fmt.Errorf("%w: code is %d bytes, want %d", ErrMismatch, len(got), len(want))
+	}
+	var skip []bool
+	if len(r.Immutables) > 0 {
+		skip = make([]bool, len(want))
+		for _, im := range r.Immutables {
+			for i := im.Start; i < im.Start+im.Length && i < len(skip); i++ {
+				skip[i] = true
+			}
+		}
+	}
+	for i := range want {
+		if want[i] != got[i] && (skip == nil || !skip[i]) {
+			return fmt.Errorf("%w: first difference at byte %d", ErrMismatch, i)
+		}
+	}
+	return nil
+}
+
+// Verify 读取 address 在最新区块的代码并与 r 比较。
+func (r *Runtime) Verify(ctx context.Context, caller bind.ContractCaller, address common.Address) error {
+	code, err := caller.CodeAt(ctx, address, nil)
+	if err != nil {
+		return err
+	}
+	if err := r.Match(code); err != nil {
+		return fmt.Errorf("%s: %w", address.Hex(), err)
+	}
+	return nil
+}
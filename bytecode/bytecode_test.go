@@ -0,0 +1,66 @@
+package bytecode
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// 0.8.30 生成的元数据：a2 64 "ipfs" <34 字节> 64 "solc" 43 <版本> 00 33
+func metadata(fill byte) []byte {
+	m := append([]byte{0xa2, 0x64}, "ipfs"...)
+	m = append(m, 0x58, 0x22)
+	m = append(m, bytes.Repeat([]byte{fill}, 34)...)
+	m = append(m, 0x64)
+	m = append(m, "solc"...)
+	m = append(m, 0x43, 0x00, 0x08, 0x1e)
+	return append(m, 0x00, byte(len(m)))
+}
+
+func TestStripMetadata(t *testing.T) {
+	body := common.FromHex("0x6080604052348015600e575f5ffd5b50")
+	code := append(append([]byte{}, body...), metadata(1)...)
+	if got := StripMetadata(code); !bytes.Equal(got, body) {
+		t.Errorf("StripMetadata = %x; want %x", got, body)
+	}
+	// 没有元数据时原样返回
+	for _, code := range [][]byte{nil, {0x00}, body, common.FromHex("0x60806040ffff")} {
+		if got := StripMetadata(code); !bytes.Equal(got, code) {
+			t.Errorf("StripMetadata(%x) = %x; want unchanged", code, got)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	body := common.FromHex("0x7f00000000000000000000000000000000000000000000000000000000000000005f5260205ff3")
+	r := &Runtime{
+		Code:       append(append([]byte{}, body...), metadata(1)...),
+		Immutables: []Range{{Start: 1, Length: 32}},
+	}
+	deployed := func(patch func([]byte)) []byte {
+		code := append(append([]byte{}, body...), metadata(2)...)
+		patch(code)
+		return code
+	}
+
+	tests := []struct {
+		name string
+		code []byte
+		want error
+	}{
+		{"identical", deployed(func([]byte) {}), nil},
+		{"immutable set", deployed(func(c []byte) { c[1], c[32] = 0xaa, 0xbb }), nil},
+		{"opcode changed", deployed(func(c []byte) { c[33] = 0x60 }), ErrMismatch},
+		{"byte after immutable", deployed(func(c []byte) { c[34] = 0x01 }), ErrMismatch},
+		{"truncated", body[:10], ErrMismatch},
+		{"empty", nil, ErrNoCode},
+	}
+	for _, tt := range tests {
+		err := r.Match(tt.code)
+		if tt.want == nil && err != nil || !errors.Is(err, tt.want) {
+			t.Errorf("%s: Match = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/clc781032855/go_ethereum/config"
+)
+
+// 默认重试参数
+const (
+	DefaultMaxAttempts = 4
+	DefaultBaseDelay   = 200 * time.Millisecond
+	DefaultMaxDelay    = 5 * time.Second
+)
+
+// Endpoint 是一个已连接的 RPC 节点
+type Endpoint struct {
+	URL    string
+	Client *ethclient.Client
+}
+
+// BackendOptions 控制只读调用的重试、退避和限流。
+type BackendOptions struct {
+	MaxAttempts int               // 每次调用的最大尝试次数（跨节点累计），默认 4
+	BaseDelay   time.Duration     // 指数退避的基准间隔，默认 200ms
+	MaxDelay    time.Duration     // 单次退避的上限，默认 5s
+	RateLimit   *config.RateLimit // 每个节点各自的令牌桶限流，nil 表示不限流
+}
+
+// EndpointStats 是单个节点的限流统计
+type EndpointStats struct {
+	URL string // 已去掉路径，不含 API Key
+	LimiterStats
+}
+
+// limitedEndpoint 是带限流器的节点
+type limitedEndpoint struct {
+	Endpoint
+	limiter *Limiter
+}
+
+// Backend 把多个 RPC 节点组合成一个 bind.ContractBackend。
+//
+// 只读调用都是幂等的：遇到连接错误、超时、HTTP 429/5xx 时按带抖动的指数退避重试，
+// 并切换到下一个节点。节点明确返回的 JSON-RPC 错误（例如执行回滚）不会重试。
+//
+// SendTransaction 不是盲目重发：只有在结果未知（传输错误）时才换节点，
+// 换节点前先查询交易是否已被节点知晓，已知则视为发送成功。
+type Backend struct {
+	endpoints []limitedEndpoint
+	current   atomic.Int32
+	opts      BackendOptions
+}
+
+var _ bind.ContractBackend = (*Backend)(nil)
+
+// NewBackend 创建多节点后端，endpoints 按优先级排列，至少需要一个。
+func NewBackend(endpoints []Endpoint, opts BackendOptions) *Backend {
+	if len(endpoints) == 0 {
+		panic("chain: NewBackend needs at least one endpoint")
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultMaxAttempts
+	}
+	if opts.BaseDelay <= 0 {
+		opts.BaseDelay = DefaultBaseDelay
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultMaxDelay
+	}
+	b := &Backend{opts: opts}
+	for _, ep := range endpoints {
+		var limiter *Limiter
+		if opts.RateLimit != nil {
+			limiter = NewLimiter(*opts.RateLimit)
+		}
+		b.endpoints = append(b.endpoints, limitedEndpoint{Endpoint: ep, limiter: limiter})
+	}
+	return b
+}
+
+// Stats 返回每个节点的限流统计，顺序与创建时一致。
+func (b *Backend) Stats() []EndpointStats {
+	stats := make([]EndpointStats, len(b.endpoints))
+	for i, ep := range b.endpoints {
+		stats[i] = EndpointStats{URL: redact(ep.URL), LimiterStats: ep.limiter.Stats()}
+	}
+	return stats
+}
+
+// Close 关闭所有节点连接。
+func (b *Backend) Close() {
+	for _, ep := range b.endpoints {
+		ep.Client.Close()
+	}
+}
+
+// Endpoints 返回节点数量。
+func (b *Backend) Endpoints() int { return len(b.endpoints) }
+
+// pick 返回当前使用的节点及其序号。
+func (b *Backend) pick() (limitedEndpoint, int32) {
+	i := b.current.Load()
+	return b.endpoints[i], i
+}
+
+// failover 在节点 i 失败后切换到下一个节点。并发失败时只切换一次。
+func (b *Backend) failover(i int32) {
+	b.current.CompareAndSwap(i, (i+1)%int32(len(b.endpoints)))
+}
+
+// backoff 在第 attempt 次重试前等待，间隔在 [0, min(MaxDelay, BaseDelay*2^(attempt-1))] 内随机（full jitter）。
+func (b *Backend) backoff(ctx context.Context, attempt int) error {
+	limit := b.opts.BaseDelay << (attempt - 1)
+	if limit <= 0 || limit > b.opts.MaxDelay {
+		limit = b.opts.MaxDelay
+	}
+	timer := time.NewTimer(time.Duration(rand.Int63n(int64(limit) + 1)))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// interrupted 返回重试被 ctx 结束打断时的错误：err 是 ctx 的错误，lastErr 是之前最后一次失败，
+// 两者都保留，errors.Is 既能判断超时 / 取消，也能判断节点的错误。
+func interrupted(err, lastErr error) error {
+	if lastErr == nil {
+		return err
+	}
+	return fmt.Errorf("%w: %w", err, lastErr)
+}
+
+// call 在节点上执行幂等调用 method，可重试的错误会退避后在下一个节点上重试。
+// 每次尝试前先通过该节点的限流器。
+func call[T any](ctx context.Context, b *Backend, method string, fn func(*ethclient.Client) (T, error)) (T, error) {
+	var (
+		zero    T
+		lastErr error
+	)
+	for attempt := 0; attempt < b.opts.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			if err := b.backoff(ctx, attempt); err != nil {
+				return zero, interrupted(err, lastErr)
+			}
+		}
+		ep, i := b.pick()
+		if err := ep.limiter.Wait(ctx, method); err != nil {
+			return zero, interrupted(err, lastErr)
+		}
+		v, err := fn(ep.Client)
+		if err == nil {
+			return v, nil
+		}
+		if !Retryable(ctx, err) {
+			return zero, err
+		}
+		lastErr = fmt.Errorf("%s: %w", redact(ep.URL), err)
+		b.failover(i)
+	}
+	return zero, lastErr
+}
+
+// Retryable 判断错误是否可以在其他节点上重试：传输错误、HTTP 429/5xx 和节点限流可以，
+// 节点明确给出的 JSON-RPC 错误、NotFound 和 eth_getLogs 范围过大不可以。ctx 已结束时不重试。
+func Retryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	// 部分节点对范围过大也返回 -32005，原样重试只会再次被拒绝，应由调用方缩小范围
+	if IsRangeTooLarge(err) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode() == -32005 // limit exceeded
+	}
+	return true
+}
+
+// redact 只保留 URL 的协议和主机，避免在错误信息里泄露路径中的 API Key。
+func redact(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "endpoint"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// rangeErrors 是各家节点拒绝过大 eth_getLogs 范围时错误信息中的片段（小写），例如
+// "block range is too wide"、"exceed maximum block range"、Infura 的 "query returned more than 10000 results"、
+// Alchemy 的 "Log response size exceeded"、QuickNode 的 "eth_getLogs is limited to a 10,000 range"
+var rangeErrors = []string{
+	"block range",
+	"range too large",
+	"query returned more than",
+	"response size exceeded",
+	"is limited to a",
+	"too many logs",
+	"too many results",
+	"query timeout",
+}
+
+// IsRangeTooLarge 判断 err 是否表示 eth_getLogs 的区块范围或结果过大，缩小范围后可能成功。
+// 只看错误信息：-32005 也用于请求限流，不能单凭错误码判断。
+func IsRangeTooLarge(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range rangeErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// knownTxErrors 是节点因为已经收到同一笔交易而拒绝广播时错误信息中的片段（小写）
+var knownTxErrors = []string{
+	"already known",
+	"known transaction",
+}
+
+// IsKnownTx 判断节点是否因为已经收到同一笔交易而拒绝，重复广播不算错误。
+func IsKnownTx(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range knownTxErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// SendTransaction 广播交易。节点明确拒绝时直接返回错误；传输失败时结果未知，
+// 切换节点后先按哈希查询，节点已知这笔交易时不再重复广播。
+// 重发的是同一笔已签名交易（哈希相同），即使两个节点都收到也只会上链一次。
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	var lastErr error
+	for attempt := 0; attempt < b.opts.MaxAttempts; attempt++ {
+		ep, i := b.pick()
+		if attempt > 0 {
+			if err := b.backoff(ctx, attempt); err != nil {
+				return interrupted(err, lastErr)
+			}
+			if err := ep.limiter.Wait(ctx, "eth_getTransactionByHash"); err != nil {
+				return interrupted(err, lastErr)
+			}
+			if _, _, err := ep.Client.TransactionByHash(ctx, tx.Hash()); err == nil {
+				return nil
+			}
+		}
+		if err := ep.limiter.Wait(ctx, "eth_sendRawTransaction"); err != nil {
+			return interrupted(err, lastErr)
+		}
+		err := ep.Client.SendTransaction(ctx, tx)
+		if err == nil || (attempt > 0 && IsKnownTx(err)) {
+			return nil
+		}
+		if !Retryable(ctx, err) {
+			return err
+		}
+		lastErr = fmt.Errorf("%s: %w", redact(ep.URL), err)
+		b.failover(i)
+	}
+	return lastErr
+}
+
+// SubscribeFilterLogs 在第一个支持订阅的节点上订阅日志，订阅本身不重试。
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	start := b.current.Load()
+	var lastErr error
+	for k := range b.endpoints {
+		ep := b.endpoints[(int(start)+k)%len(b.endpoints)]
+		if err := ep.limiter.Wait(ctx, "eth_subscribe"); err != nil {
+			return nil, err
+		}
+		sub, err := ep.Client.SubscribeFilterLogs(ctx, q, ch)
+		if err == nil {
+			return sub, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(ctx, b, "eth_chainId", func(c *ethclient.Client) (*big.Int, error) { return c.ChainID(ctx) })
+}
+
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(ctx, b, "eth_blockNumber", func(c *ethclient.Client) (uint64, error) { return c.BlockNumber(ctx) })
+}
+
+func (b *Backend) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return call(ctx, b, "eth_getBlockByNumber", func(c *ethclient.Client) (*types.Block, error) { return c.BlockByNumber(ctx, number) })
+}
+
+func (b *Backend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return call(ctx, b, "eth_getBlockByHash", func(c *ethclient.Client) (*types.Block, error) { return c.BlockByHash(ctx, hash) })
+}
+
+func (b *Backend) BlockReceipts(ctx context.Context, id rpc.BlockNumberOrHash) ([]*types.Receipt, error) {
+	return call(ctx, b, "eth_getBlockReceipts", func(c *ethclient.Client) ([]*types.Receipt, error) { return c.BlockReceipts(ctx, id) })
+}
+
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return call(ctx, b, "eth_getBlockByNumber", func(c *ethclient.Client) (*types.Header, error) { return c.HeaderByNumber(ctx, number) })
+}
+
+func (b *Backend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx      *types.Transaction
+		pending bool
+	}
+	r, err := call(ctx, b, "eth_getTransactionByHash", func(c *ethclient.Client) (result, error) {
+		tx, pending, err := c.TransactionByHash(ctx, hash)
+		return result{tx, pending}, err
+	})
+	return r.tx, r.pending, err
+}
+
+func (b *Backend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return call(ctx, b, "eth_getTransactionReceipt", func(c *ethclient.Client) (*types.Receipt, error) { return c.TransactionReceipt(ctx, hash) })
+}
+
+func (b *Backend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(ctx, b, "eth_getBalance", func(c *ethclient.Client) (*big.Int, error) { return c.BalanceAt(ctx, account, blockNumber) })
+}
+
+func (b *Backend) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return call(ctx, b, "eth_getTransactionCount", func(c *ethclient.Client) (uint64, error) { return c.NonceAt(ctx, account, blockNumber) })
+}
+
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return call(ctx, b, "eth_getTransactionCount", func(c *ethclient.Client) (uint64, error) { return c.PendingNonceAt(ctx, account) })
+}
+
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, b, "eth_getCode", func(c *ethclient.Client) ([]byte, error) { return c.CodeAt(ctx, contract, blockNumber) })
+}
+
+func (b *Backend) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, b, "eth_getStorageAt", func(c *ethclient.Client) ([]byte, error) { return c.StorageAt(ctx, account, key, blockNumber) })
+}
+
+func (b *Backend) PendingCodeAt(ctx context.Context, contract common.Address) ([]byte, error) {
+	return call(ctx, b, "eth_getCode", func(c *ethclient.Client) ([]byte, error) { return c.PendingCodeAt(ctx, contract) })
+}
+
+func (b *Backend) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, b, "eth_call", func(c *ethclient.Client) ([]byte, error) { return c.CallContract(ctx, msg, blockNumber) })
+}
+
+func (b *Backend) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	return call(ctx, b, "eth_call", func(c *ethclient.Client) ([]byte, error) { return c.PendingCallContract(ctx, msg) })
+}
+
+func (b *Backend) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(ctx, b, "eth_estimateGas", func(c *ethclient.Client) (uint64, error) { return c.EstimateGas(ctx, msg) })
+}
+
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(ctx, b, "eth_gasPrice", func(c *ethclient.Client) (*big.Int, error) { return c.SuggestGasPrice(ctx) })
+}
+
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(ctx, b, "eth_maxPriorityFeePerGas", func(c *ethclient.Client) (*big.Int, error) { return c.SuggestGasTipCap(ctx) })
+}
+
+func (b *Backend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return call(ctx, b, "eth_getLogs", func(c *ethclient.Client) ([]types.Log, error) { return c.FilterLogs(ctx, q) })
+}
@@ -0,0 +1,313 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/clc781032855/go_ethereum/internal/fakerpc"
+)
+
+// testOptions 让重试几乎不等待
+var testOptions = BackendOptions{MaxAttempts: DefaultMaxAttempts, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+
+// newTestBackend 按顺序把节点组合成 Backend。
+func newTestBackend(t *testing.T, servers ...*fakerpc.Server) *Backend {
+	t.Helper()
+	endpoints := make([]Endpoint, len(servers))
+	for i, s := range servers {
+		endpoints[i] = Endpoint{URL: s.URL, Client: s.Dial(t)}
+	}
+	return NewBackend(endpoints, testOptions)
+}
+
+// always 对所有方法返回同一个响应
+func always(resp fakerpc.Response) fakerpc.Handler {
+	return func(string, json.RawMessage) fakerpc.Response { return resp }
+}
+
+// healthy 对 eth_blockNumber 返回区块 0x10
+var healthy = always(fakerpc.Response{Result: "0x10"})
+
+func TestCallFailover(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+	}{
+		{"too many requests", http.StatusTooManyRequests},
+		{"bad gateway", http.StatusBadGateway},
+		{"service unavailable", http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := fakerpc.New(t, always(fakerpc.Response{Status: tt.status}))
+			backup := fakerpc.New(t, healthy)
+			b := newTestBackend(t, primary, backup)
+
+			n, err := b.BlockNumber(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 0x10 {
+				t.Errorf("BlockNumber = %d; want 16", n)
+			}
+			if got := primary.Calls("eth_blockNumber"); got != 1 {
+				t.Errorf("primary called %d times; want 1", got)
+			}
+			if got := backup.Calls("eth_blockNumber"); got != 1 {
+				t.Errorf("backup called %d times; want 1", got)
+			}
+
+			// 切换后的节点成为当前节点，后续调用不再经过失败的节点
+			if _, err := b.BlockNumber(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if got := primary.Calls("eth_blockNumber"); got != 1 {
+				t.Errorf("primary called %d times after failover; want 1", got)
+			}
+		})
+	}
+}
+
+func TestCallGivesUpAfterMaxAttempts(t *testing.T) {
+	primary := fakerpc.New(t, always(fakerpc.Response{Status: http.StatusServiceUnavailable}))
+	backup := fakerpc.New(t, always(fakerpc.Response{Status: http.StatusServiceUnavailable}))
+	b := newTestBackend(t, primary, backup)
+
+	_, err := b.BlockNumber(context.Background())
+	var httpErr rpc.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("err = %v; want HTTP 503", err)
+	}
+	if got := primary.Calls("eth_blockNumber") + backup.Calls("eth_blockNumber"); got != DefaultMaxAttempts {
+		t.Errorf("%d attempts; want %d", got, DefaultMaxAttempts)
+	}
+}
+
+func TestCallNotRetried(t *testing.T) {
+	primary := fakerpc.New(t, always(fakerpc.Response{Error: &fakerpc.Error{Code: -32000, Message: "header not found"}}))
+	backup := fakerpc.New(t, healthy)
+	b := newTestBackend(t, primary, backup)
+
+	_, err := b.BlockNumber(context.Background())
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) || rpcErr.ErrorCode() != -32000 {
+		t.Fatalf("err = %v; want JSON-RPC error -32000", err)
+	}
+	if got := primary.Calls("eth_blockNumber"); got != 1 {
+		t.Errorf("primary called %d times; want 1", got)
+	}
+	if got := backup.Calls("eth_blockNumber"); got != 0 {
+		t.Errorf("backup called %d times; want 0", got)
+	}
+}
+
+// 退避被 ctx 打断时同时返回 ctx 的错误和最后一次失败
+func TestRetryInterrupted(t *testing.T) {
+	tx := signedTx(t)
+	for _, tc := range []struct {
+		method string
+		do     func(context.Context, *Backend) error
+	}{
+		{"eth_blockNumber", func(ctx context.Context, b *Backend) error { _, err := b.BlockNumber(ctx); return err }},
+		{"eth_sendRawTransaction", func(ctx context.Context, b *Backend) error { return b.SendTransaction(ctx, tx) }},
+	} {
+		t.Run(tc.method, func(t *testing.T) {
+			down := fakerpc.New(t, always(fakerpc.Response{Status: http.StatusServiceUnavailable}))
+			// 退避时间远长于超时，必然在退避中被打断
+			b := NewBackend([]Endpoint{{URL: down.URL, Client: down.Dial(t)}}, BackendOptions{MaxAttempts: DefaultMaxAttempts, BaseDelay: time.Hour, MaxDelay: time.Hour})
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			err := tc.do(ctx, b)
+			if !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("err = %v; want deadline exceeded", err)
+			}
+			var httpErr rpc.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+				t.Errorf("err = %v; want the HTTP 503 of the last attempt", err)
+			}
+			if got := down.Calls(tc.method); got != 1 {
+				t.Errorf("%s called %d times; want 1", tc.method, got)
+			}
+		})
+	}
+}
+
+// signedTx 返回一笔已签名的转账交易。
+func signedTx(t *testing.T) *types.Transaction {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainID := big.NewInt(1337)
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestSendTransaction(t *testing.T) {
+	tx := signedTx(t)
+	txJSON, err := tx.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 第一个节点收到了交易，但响应在传输中丢失
+	lost := always(fakerpc.Response{Status: http.StatusBadGateway})
+
+	tests := []struct {
+		name     string
+		backup   fakerpc.Handler
+		wantSent int // 备用节点收到的 eth_sendRawTransaction 次数
+	}{
+		{
+			name: "backup already has the tx",
+			backup: func(method string, _ json.RawMessage) fakerpc.Response {
+				if method == "eth_getTransactionByHash" {
+					return fakerpc.Response{Result: json.RawMessage(txJSON)}
+				}
+				return fakerpc.Response{Error: &fakerpc.Error{Code: -32000, Message: "unexpected " + method}}
+			},
+			wantSent: 0,
+		},
+		{
+			name: "backup reports already known",
+			backup: func(method string, _ json.RawMessage) fakerpc.Response {
+				if method == "eth_getTransactionByHash" {
+					return fakerpc.Response{Result: nil}
+				}
+				return fakerpc.Response{Error: &fakerpc.Error{Code: -32000, Message: "already known"}}
+			},
+			wantSent: 1,
+		},
+		{
+			name: "backup accepts the tx",
+			backup: func(method string, _ json.RawMessage) fakerpc.Response {
+				if method == "eth_getTransactionByHash" {
+					return fakerpc.Response{Result: nil}
+				}
+				return fakerpc.Response{Result: tx.Hash()}
+			},
+			wantSent: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := fakerpc.New(t, lost)
+			backup := fakerpc.New(t, tt.backup)
+			b := newTestBackend(t, primary, backup)
+
+			if err := b.SendTransaction(context.Background(), tx); err != nil {
+				t.Fatalf("SendTransaction: %v", err)
+			}
+			if got := primary.Calls("eth_sendRawTransaction"); got != 1 {
+				t.Errorf("primary sendRawTransaction calls = %d; want 1", got)
+			}
+			if got := backup.Calls("eth_getTransactionByHash"); got != 1 {
+				t.Errorf("backup getTransactionByHash calls = %d; want 1", got)
+			}
+			if got := backup.Calls("eth_sendRawTransaction"); got != tt.wantSent {
+				t.Errorf("backup sendRawTransaction calls = %d; want %d", got, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestSendTransactionRejected(t *testing.T) {
+	tx := signedTx(t)
+	// 节点明确拒绝时不换节点重发；第一次就返回 already known 也不是本次广播成功的证据
+	for _, msg := range []string{"insufficient funds for gas * price + value", "already known"} {
+		primary := fakerpc.New(t, always(fakerpc.Response{Error: &fakerpc.Error{Code: -32000, Message: msg}}))
+		backup := fakerpc.New(t, always(fakerpc.Response{Result: tx.Hash()}))
+		b := newTestBackend(t, primary, backup)
+
+		if err := b.SendTransaction(context.Background(), tx); err == nil {
+			t.Errorf("%s: SendTransaction succeeded", msg)
+		}
+		if got := backup.Calls("eth_sendRawTransaction") + backup.Calls("eth_getTransactionByHash"); got != 0 {
+			t.Errorf("%s: backup called %d times; want 0", msg, got)
+		}
+	}
+}
+
+func TestCallRangeTooLargeNotRetried(t *testing.T) {
+	// -32005 同时用于限流和范围过大：限流换节点重试，范围过大直接返回给调用方缩小范围
+	tooLarge := fakerpc.New(t, always(fakerpc.Response{Error: &fakerpc.Error{Code: -32005, Message: "query returned more than 10000 results"}}))
+	backup := fakerpc.New(t, always(fakerpc.Response{Result: []types.Log{}}))
+	b := newTestBackend(t, tooLarge, backup)
+
+	_, err := b.FilterLogs(context.Background(), ethereum.FilterQuery{})
+	if !IsRangeTooLarge(err) {
+		t.Fatalf("err = %v; want range too large", err)
+	}
+	if got := tooLarge.Calls("eth_getLogs") + backup.Calls("eth_getLogs"); got != 1 {
+		t.Errorf("eth_getLogs called %d times; want 1", got)
+	}
+
+	limited := fakerpc.New(t, always(fakerpc.Response{Error: &fakerpc.Error{Code: -32005, Message: "project ID request rate exceeded"}}))
+	backup = fakerpc.New(t, always(fakerpc.Response{Result: []types.Log{}}))
+	b = newTestBackend(t, limited, backup)
+	if _, err := b.FilterLogs(context.Background(), ethereum.FilterQuery{}); err != nil {
+		t.Fatalf("rate-limited call was not retried on the backup: %v", err)
+	}
+}
+
+func TestIsRangeTooLarge(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("query returned more than 10000 results"), true},
+		{errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), true},
+		{errors.New("eth_getLogs is limited to a 10,000 range"), true},
+		{errors.New("exceed maximum block range: 5000"), true},
+		{errors.New("project ID request rate exceeded"), false},
+		{errors.New("429 Too Many Requests"), false},
+		{errors.New("header not found"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsRangeTooLarge(tt.err); got != tt.want {
+			t.Errorf("IsRangeTooLarge(%v) = %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIsKnownTx(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("already known"), true},
+		{errors.New("Known transaction: 0xabc"), true},
+		{errors.New("nonce too low"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsKnownTx(tt.err); got != tt.want {
+			t.Errorf("IsKnownTx(%v) = %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/clc781032855/go_ethereum/config"
+)
+
+// ErrChainMismatch 表示远端节点与配置的网络不一致，此时禁止签名。
+var ErrChainMismatch = errors.New("chain mismatch")
+
+// MismatchError 记录哪一项校验失败。
+type MismatchError struct {
+	Network string
+	Field   string // "chainId" 或 "genesisHash"
+	Want    string
+	Got     string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("%s: network %s expects %s %s, remote reports %s", ErrChainMismatch, e.Network, e.Field, e.Want, e.Got)
+}
+
+func (e *MismatchError) Unwrap() error { return ErrChainMismatch }
+
+// IdentityReader 是校验链身份所需的最小 RPC 接口。
+type IdentityReader interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Verify 对比远端的链ID（以及配置了的创世区块哈希）和网络配置，返回校验通过的链ID。
+// 签名只能使用这里返回的链ID，而不是直接信任 client.ChainID。
+func Verify(ctx context.Context, client IdentityReader, network *config.Network) (*big.Int, error) {
+	if network.ChainID == 0 {
+		return nil, fmt.Errorf("network %s has no expected chainId configured", network.Name)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+	want := new(big.Int).SetUint64(network.ChainID)
+	if chainID.Cmp(want) != 0 {
+		return nil, &MismatchError{Network: network.Name, Field: "chainId", Want: want.String(), Got: chainID.String()}
+	}
+
+	if network.GenesisHash != "" {
+		genesis, err := client.HeaderByNumber(ctx, common.Big0)
+		if err != nil {
+			return nil, fmt.Errorf("get genesis header: %w", err)
+		}
+		want := common.HexToHash(network.GenesisHash)
+		if got := genesis.Hash(); got != want {
+			return nil, &MismatchError{Network: network.Name, Field: "genesisHash", Want: want.Hex(), Got: got.Hex()}
+		}
+	}
+	return chainID, nil
+}
+
+// Conn 是一个已经通过身份校验的连接，底层是多节点的 Backend。
+type Conn struct {
+	*Backend
+	Network         *config.Network
+	VerifiedChainID *big.Int // 已校验的链ID
+}
+
+// Dial 连接所选网络的主节点和备用节点，并逐个校验链身份。
+// 任何节点的链ID或创世区块不符都视为配置错误，直接返回错误；
+// 连接不上的节点被跳过，至少要有一个节点通过校验。
+func Dial(ctx context.Context, network *config.Network) (*Conn, error) {
+	var (
+		endpoints []Endpoint
+		chainID   *big.Int
+		firstErr  error
+	)
+	closeAll := func() {
+		for _, ep := range endpoints {
+			ep.Client.Close()
+		}
+	}
+	for _, rawURL := range network.RPCURLs() {
+		client, err := ethclient.DialContext(ctx, rawURL)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("dial %s (%s): %w", network.Name, redact(rawURL), err)
+			}
+			continue
+		}
+		id, err := Verify(ctx, client, network)
+		if err != nil {
+			client.Close()
+			if errors.Is(err, ErrChainMismatch) {
+				closeAll()
+				return nil, fmt.Errorf("%s: %w", redact(rawURL), err)
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		chainID = id
+		endpoints = append(endpoints, Endpoint{URL: rawURL, Client: client})
+	}
+	if len(endpoints) == 0 {
+		return nil, firstErr
+	}
+	return &Conn{Backend: NewBackend(endpoints, BackendOptions{RateLimit: network.RateLimit}), Network: network, VerifiedChainID: chainID}, nil
+}
@@ -0,0 +1,149 @@
+package chain
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/clc781032855/go_ethereum/config"
+)
+
+// DefaultComputeUnits 是未列在 ComputeUnits 中的方法的计费
+const DefaultComputeUnits = 20
+
+// ComputeUnits 是各 JSON-RPC 方法的计算单元，参考 Alchemy 的计费表。
+var ComputeUnits = map[string]int{
+	"eth_chainId":               0,
+	"eth_blockNumber":           10,
+	"eth_getBlockByNumber":      16,
+	"eth_getBlockByHash":        16,
+	"eth_getBlockReceipts":      500,
+	"eth_getTransactionByHash":  17,
+	"eth_getTransactionReceipt": 15,
+	"eth_getBalance":            19,
+	"eth_getTransactionCount":   26,
+	"eth_getCode":               26,
+	"eth_getStorageAt":          17,
+	"eth_call":                  26,
+	"eth_estimateGas":           87,
+	"eth_gasPrice":              19,
+	"eth_maxPriorityFeePerGas":  10,
+	"eth_getLogs":               75,
+	"eth_sendRawTransaction":    250,
+	"eth_subscribe":             10,
+}
+
+// computeUnits 返回方法的计费。
+func computeUnits(method string) int {
+	if cu, ok := ComputeUnits[method]; ok {
+		return cu
+	}
+	return DefaultComputeUnits
+}
+
+// LimiterStats 是限流器的统计数据
+type LimiterStats struct {
+	Requests      uint64        // 通过限流器的请求数
+	ComputeUnits  uint64        // 消耗的计算单元
+	Throttled     uint64        // 因限流而等待的请求数
+	ThrottledWait time.Duration // 因限流而等待的总时长
+}
+
+// Limiter 是单个节点的令牌桶限流器，同时限制请求数和计算单元。零值和 nil 都表示不限流。
+type Limiter struct {
+	requests *rate.Limiter
+	units    *rate.Limiter
+
+	count     atomic.Uint64
+	cu        atomic.Uint64
+	throttled atomic.Uint64
+	waited    atomic.Int64
+}
+
+// NewLimiter 按配置创建限流器。桶容量为一秒的配额，计算单元的容量至少能容纳最贵的单次调用。
+func NewLimiter(cfg config.RateLimit) *Limiter {
+	l := &Limiter{}
+	if cfg.RequestsPerSecond > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), burst(cfg.RequestsPerSecond, 1))
+	}
+	if cfg.ComputeUnitsPerSecond > 0 {
+		maxCost := DefaultComputeUnits
+		for _, cu := range ComputeUnits {
+			maxCost = max(maxCost, cu)
+		}
+		l.units = rate.NewLimiter(rate.Limit(cfg.ComputeUnitsPerSecond), burst(cfg.ComputeUnitsPerSecond, maxCost))
+	}
+	return l
+}
+
+func burst(perSecond float64, least int) int {
+	return max(int(math.Ceil(perSecond)), least)
+}
+
+// Wait 等待调用 method 所需的请求配额和计算单元，ctx 结束时返回其错误。
+// 两种令牌在同一时刻预留，等待其中较长的一个；ctx 结束时在当时取消两个预留，
+// 还没到可用时间的令牌归还给桶。不能按预留时刻取消：其他调用者之后的预留会被回退，桶会多出令牌。
+func (l *Limiter) Wait(ctx context.Context, method string) error {
+	if l == nil {
+		return nil
+	}
+	cost := computeUnits(method)
+	now := time.Now()
+	var (
+		reservations []*rate.Reservation
+		delay        time.Duration
+	)
+	cancel := func() {
+		for _, r := range reservations {
+			r.Cancel()
+		}
+	}
+	for _, item := range []struct {
+		lim *rate.Limiter
+		n   int
+	}{{l.requests, 1}, {l.units, cost}} {
+		if item.lim == nil || item.n == 0 {
+			continue
+		}
+		r := item.lim.ReserveN(now, item.n)
+		if !r.OK() {
+			cancel()
+			return context.DeadlineExceeded // n 超过桶容量，NewLimiter 已保证不会发生
+		}
+		reservations = append(reservations, r)
+		delay = max(delay, r.DelayFrom(now))
+	}
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			cancel()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	l.count.Add(1)
+	l.cu.Add(uint64(cost))
+	if delay > 0 {
+		l.throttled.Add(1)
+		l.waited.Add(int64(delay))
+	}
+	return nil
+}
+
+// Stats 返回统计数据快照。
+func (l *Limiter) Stats() LimiterStats {
+	if l == nil {
+		return LimiterStats{}
+	}
+	return LimiterStats{
+		Requests:      l.count.Load(),
+		ComputeUnits:  l.cu.Load(),
+		Throttled:     l.throttled.Load(),
+		ThrottledWait: time.Duration(l.waited.Load()),
+	}
+}
@@ -0,0 +1,168 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/clc781032855/go_ethereum/config"
+)
+
+// waitWithin 在 timeout 内等待一次 method 的配额。
+func waitWithin(l *Limiter, method string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return l.Wait(ctx, method)
+}
+
+func TestLimiterBurst(t *testing.T) {
+	l := NewLimiter(config.RateLimit{RequestsPerSecond: 5})
+
+	// 桶容量是一秒的配额：前 5 次不等待
+	for i := 0; i < 5; i++ {
+		if err := waitWithin(l, "eth_blockNumber", 10*time.Millisecond); err != nil {
+			t.Fatalf("request %d within burst: %v", i, err)
+		}
+	}
+	if stats := l.Stats(); stats.Requests != 5 || stats.Throttled != 0 {
+		t.Fatalf("stats after burst = %+v; want 5 requests, 0 throttled", stats)
+	}
+
+	// 第 6 次需要等一个令牌（200ms）
+	start := time.Now()
+	if err := waitWithin(l, "eth_blockNumber", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("throttled request waited %v; want about 200ms", elapsed)
+	}
+	stats := l.Stats()
+	if stats.Requests != 6 || stats.Throttled != 1 {
+		t.Errorf("stats = %+v; want 6 requests, 1 throttled", stats)
+	}
+	if stats.ThrottledWait < 150*time.Millisecond || stats.ThrottledWait > 250*time.Millisecond {
+		t.Errorf("ThrottledWait = %v; want about 200ms", stats.ThrottledWait)
+	}
+	if stats.ComputeUnits != 6*uint64(ComputeUnits["eth_blockNumber"]) {
+		t.Errorf("ComputeUnits = %d; want %d", stats.ComputeUnits, 6*ComputeUnits["eth_blockNumber"])
+	}
+}
+
+func TestLimiterComputeUnits(t *testing.T) {
+	// 计算单元的桶至少能容纳最贵的单次调用（eth_getBlockReceipts 500）
+	l := NewLimiter(config.RateLimit{ComputeUnitsPerSecond: 100})
+	if err := waitWithin(l, "eth_getBlockReceipts", 10*time.Millisecond); err != nil {
+		t.Fatalf("expensive call within burst: %v", err)
+	}
+	// 桶已空，下一次 eth_getLogs（75）需要等 0.75s
+	if err := waitWithin(l, "eth_getLogs", 50*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v; want deadline exceeded", err)
+	}
+	// 不计费的方法不受计算单元限制
+	if err := waitWithin(l, "eth_chainId", 10*time.Millisecond); err != nil {
+		t.Errorf("free call: %v", err)
+	}
+	if stats := l.Stats(); stats.Requests != 2 || stats.ComputeUnits != 500 {
+		t.Errorf("stats = %+v; want 2 requests, 500 compute units", stats)
+	}
+}
+
+func TestLimiterCancelReturnsTokens(t *testing.T) {
+	// 桶容量 2，之后每 500ms 一个令牌
+	l := NewLimiter(config.RateLimit{RequestsPerSecond: 2})
+	for i := 0; i < 2; i++ {
+		if err := waitWithin(l, "eth_chainId", 10*time.Millisecond); err != nil {
+			t.Fatalf("request %d within burst: %v", i, err)
+		}
+	}
+
+	// 等待下一个令牌时超时：预留的令牌必须归还
+	if err := waitWithin(l, "eth_chainId", 20*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v; want deadline exceeded", err)
+	}
+	// 下一个令牌不到 500ms 后可用；如果被超时的调用占用，需要再等约 1s
+	if err := waitWithin(l, "eth_chainId", 800*time.Millisecond); err != nil {
+		t.Errorf("token was not returned after cancellation: %v", err)
+	}
+	if stats := l.Stats(); stats.Requests != 3 || stats.Throttled != 1 {
+		t.Errorf("stats = %+v; want 3 requests, 1 throttled (cancelled waits are not counted)", stats)
+	}
+}
+
+func TestLimiterConcurrentCancelKeepsRate(t *testing.T) {
+	const rps = 20
+	l := NewLimiter(config.RateLimit{RequestsPerSecond: rps})
+
+	// 一半调用者很快超时取消，另一半一直等到拿到令牌
+	var (
+		wg     sync.WaitGroup
+		served atomic.Int64
+	)
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		timeout := 30 * time.Millisecond
+		if i%2 == 0 {
+			timeout = 10 * time.Second
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := waitWithin(l, "eth_chainId", timeout)
+			switch {
+			case err == nil:
+				served.Add(1)
+			case timeout > time.Second:
+				t.Errorf("patient waiter failed: %v", err)
+			}
+		}()
+		time.Sleep(5 * time.Millisecond)
+	}
+	wg.Wait()
+
+	// 取消不能让桶多出令牌：放行数不超过初始容量加上这段时间产生的令牌
+	elapsed := time.Since(start)
+	if n, limit := served.Load(), int64(rps+elapsed.Seconds()*rps)+1; n > limit {
+		t.Errorf("%d requests served in %v; the limit allows at most %d", n, elapsed.Round(time.Millisecond), limit)
+	}
+}
+
+func TestNilLimiter(t *testing.T) {
+	var l *Limiter
+	if err := l.Wait(context.Background(), "eth_call"); err != nil {
+		t.Fatal(err)
+	}
+	if stats := l.Stats(); stats != (LimiterStats{}) {
+		t.Errorf("Stats of nil limiter = %+v", stats)
+	}
+}
+
+func TestLimiterCancelOutOfOrder(t *testing.T) {
+	// 桶容量 1，之后每秒一个令牌
+	l := NewLimiter(config.RateLimit{RequestsPerSecond: 1})
+	if err := waitWithin(l, "eth_chainId", 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+
+	// 先预留的调用者后取消：按预留时刻取消会把限流器的时间回退约 800ms，下一个令牌立即可用
+	first := make(chan error)
+	go func() { first <- waitWithin(l, "eth_chainId", 900*time.Millisecond) }()
+	time.Sleep(600 * time.Millisecond)
+	if err := waitWithin(l, "eth_chainId", 200*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("second waiter: err = %v; want deadline exceeded", err)
+	}
+	if err := <-first; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("first waiter: err = %v; want deadline exceeded", err)
+	}
+
+	// 下一个令牌在 start 之后 1s 才产生
+	if err := waitWithin(l, "eth_chainId", 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 950*time.Millisecond {
+		t.Errorf("token served %v after the bucket was drained; want about 1s", elapsed.Round(time.Millisecond))
+	}
+}
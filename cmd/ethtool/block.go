@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+)
+
+var blockCommand = &cli.Command{
+	Name:      "block",
+	Usage:     "查询区块头、交易和叔块详情",
+	ArgsUsage: "[区块号 | 区块哈希 | latest | safe | finalized | pending | earliest]",
+	Action:    blockInfo,
+}
+
+// blockTags 是支持的区块标签
+var blockTags = map[string]rpc.BlockNumber{
+	"latest":    rpc.LatestBlockNumber,
+	"safe":      rpc.SafeBlockNumber,
+	"finalized": rpc.FinalizedBlockNumber,
+	"pending":   rpc.PendingBlockNumber,
+	"earliest":  rpc.EarliestBlockNumber,
+}
+
+// parseBlockID 解析区块号（十进制或 0x 十六进制）、区块哈希或区块标签。
+func parseBlockID(s string) (rpc.BlockNumberOrHash, error) {
+	if s == "" {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber), nil
+	}
+	if tag, ok := blockTags[strings.ToLower(s)]; ok {
+		return rpc.BlockNumberOrHashWithNumber(tag), nil
+	}
+	if len(s) == 66 && strings.HasPrefix(s, "0x") {
+		hash, err := hexutil.Decode(s)
+		if err != nil {
+			return rpc.BlockNumberOrHash{}, usageError("block.bad_hash", s)
+		}
+		return rpc.BlockNumberOrHashWithHash(common.BytesToHash(hash), false), nil
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok || n.Sign() < 0 || !n.IsInt64() {
+		return rpc.BlockNumberOrHash{}, usageError("block.bad_number", s)
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(n.Int64())), nil
+}
+
+// blockRecord 是 block 命令的结构化输出，字段名与 JSON-RPC 保持一致
+type blockRecord struct {
+	Number                *output.BigInt `json:"number"`
+	Hash                  common.Hash    `json:"hash"`
+	ParentHash            common.Hash    `json:"parentHash"`
+	Timestamp             uint64         `json:"timestamp"`
+	Miner                 common.Address `json:"miner"`
+	GasUsed               uint64         `json:"gasUsed"`
+	GasLimit              uint64         `json:"gasLimit"`
+	BaseFeePerGas         *output.BigInt `json:"baseFeePerGas"`
+	StateRoot             common.Hash    `json:"stateRoot"`
+	TransactionsRoot      common.Hash    `json:"transactionsRoot"`
+	ReceiptsRoot          common.Hash    `json:"receiptsRoot"`
+	Sha3Uncles            common.Hash    `json:"sha3Uncles"`
+	WithdrawalsRoot       *common.Hash   `json:"withdrawalsRoot"`
+	BlobGasUsed           *uint64        `json:"blobGasUsed"`
+	ExcessBlobGas         *uint64        `json:"excessBlobGas"`
+	ParentBeaconBlockRoot *common.Hash   `json:"parentBeaconBlockRoot"`
+	RequestsHash          *common.Hash   `json:"requestsHash"`
+	Difficulty            *output.BigInt `json:"difficulty"`
+	MixHash               common.Hash    `json:"mixHash"`
+	Nonce                 uint64         `json:"nonce"`
+	ExtraData             hexutil.Bytes  `json:"extraData"`
+	WithdrawalCount       int            `json:"withdrawalCount"`
+	Uncles                []common.Hash  `json:"uncles"`
+	Transactions          []txRecord     `json:"transactions"`
+}
+
+// txRecord 是区块中一笔交易的展示信息，金额和手续费以 Wei 为单位
+type txRecord struct {
+	Index uint            `json:"index"`
+	Hash  common.Hash     `json:"hash"`
+	Type  uint8           `json:"type"`
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to"` // 合约创建时为 null
+	Value *output.BigInt  `json:"value"`
+	Fee   *output.BigInt  `json:"fee"` // 需要交易回执，取不到时为 null
+}
+
+func newBlockRecord(block *types.Block, txs []txRecord) *blockRecord {
+	h := block.Header()
+	uncles := make([]common.Hash, len(block.Uncles()))
+	for i, uncle := range block.Uncles() {
+		uncles[i] = uncle.Hash()
+	}
+	return &blockRecord{
+		Number:                output.NewBigInt(h.Number),
+		Hash:                  h.Hash(),
+		ParentHash:            h.ParentHash,
+		Timestamp:             h.Time,
+		Miner:                 h.Coinbase,
+		GasUsed:               h.GasUsed,
+		GasLimit:              h.GasLimit,
+		BaseFeePerGas:         output.NewBigInt(h.BaseFee),
+		StateRoot:             h.Root,
+		TransactionsRoot:      h.TxHash,
+		ReceiptsRoot:          h.ReceiptHash,
+		Sha3Uncles:            h.UncleHash,
+		WithdrawalsRoot:       h.WithdrawalsHash,
+		BlobGasUsed:           h.BlobGasUsed,
+		ExcessBlobGas:         h.ExcessBlobGas,
+		ParentBeaconBlockRoot: h.ParentBeaconRoot,
+		RequestsHash:          h.RequestsHash,
+		Difficulty:            output.NewBigInt(h.Difficulty),
+		MixHash:               h.MixDigest,
+		Nonce:                 h.Nonce.Uint64(),
+		ExtraData:             h.Extra,
+		WithdrawalCount:       len(block.Withdrawals()),
+		Uncles:                uncles,
+		Transactions:          txs,
+	}
+}
+
+func blockInfo(c *cli.Context) error {
+	id, err := parseBlockID(c.Args().First())
+	if err != nil {
+		return err
+	}
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+
+	var block *types.Block
+	if hash, ok := id.Hash(); ok {
+		block, err = s.client.BlockByHash(ctx, hash)
+	} else {
+		number, _ := id.Number()
+		block, err = s.client.BlockByNumber(ctx, big.NewInt(number.Int64()))
+	}
+	if err != nil {
+		return fail(err, errs.Unknown, "block.fetch_failed")
+	}
+
+	// 手续费需要回执，节点不支持 eth_getBlockReceipts（或 pending 区块）时只输出警告
+	receipts, err := s.client.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false))
+	if err != nil {
+		out.Println(i18n.T("block.receipts_failed", err))
+		receipts = nil
+	}
+
+	txs := collectTxs(block, receipts, s.client.VerifiedChainID)
+	// CSV 无法表达嵌套结构，只输出交易表
+	if out.Format() == output.CSV {
+		return out.Emit(txs, nil)
+	}
+	return out.Emit(newBlockRecord(block, txs), func() {
+		printHeader(block.Header())
+		out.Println(i18n.T("block.tx_count", len(block.Transactions())))
+		out.Println(i18n.T("block.withdrawal_count", len(block.Withdrawals())))
+
+		if uncles := block.Uncles(); len(uncles) > 0 {
+			out.Println(i18n.T("block.uncles", len(uncles)))
+			for i, uncle := range uncles {
+				out.Println(i18n.T("block.uncle", i, uncle.Number, uncle.Hash().Hex(), uncle.Coinbase.Hex()))
+			}
+		}
+		if len(txs) > 0 {
+			printTxTable(txs)
+		}
+	})
+}
+
+// collectTxs 恢复每笔交易的发送方并计算手续费。
+func collectTxs(block *types.Block, receipts []*types.Receipt, chainID *big.Int) []txRecord {
+	signer := types.LatestSignerForChainID(chainID)
+	views := make([]txRecord, 0, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		v := txRecord{Index: uint(i), Hash: tx.Hash(), Type: tx.Type(), To: tx.To(), Value: output.NewBigInt(tx.Value())}
+		if from, err := types.Sender(signer, tx); err == nil {
+			v.From = from
+		}
+		if i < len(receipts) && receipts[i].TxHash == tx.Hash() {
+			v.Fee = output.NewBigInt(receiptFee(receipts[i]))
+		}
+		views = append(views, v)
+	}
+	return views
+}
+
+// receiptFee 计算交易实际支付的手续费（含 blob 费用）。
+func receiptFee(r *types.Receipt) *big.Int {
+	if r.EffectiveGasPrice == nil {
+		return nil
+	}
+	fee := new(big.Int).Mul(r.EffectiveGasPrice, new(big.Int).SetUint64(r.GasUsed))
+	if r.BlobGasPrice != nil {
+		fee.Add(fee, new(big.Int).Mul(r.BlobGasPrice, new(big.Int).SetUint64(r.BlobGasUsed)))
+	}
+	return fee
+}
+
+// printHeader 输出区块头的全部字段，分叉之前不存在的字段不输出。
+func printHeader(h *types.Header) {
+	out.Println(i18n.T("block.title"))
+	out.Println(i18n.T("block.number", h.Number))
+	out.Println(i18n.T("block.hash", h.Hash().Hex()))
+	out.Println(i18n.T("block.parent_hash", h.ParentHash.Hex()))
+	out.Println(i18n.T("block.time", h.Time, time.Unix(int64(h.Time), 0).UTC().Format(time.RFC3339)))
+	out.Println(i18n.T("block.miner", h.Coinbase.Hex()))
+	out.Println(i18n.T("block.gas", h.GasUsed, h.GasLimit, percent(h.GasUsed, h.GasLimit)))
+	if h.BaseFee != nil {
+		out.Println(i18n.T("block.base_fee", h.BaseFee, formatUnits(h.BaseFee, 9)))
+	}
+	out.Println(i18n.T("block.state_root", h.Root.Hex()))
+	out.Println(i18n.T("block.tx_root", h.TxHash.Hex()))
+	out.Println(i18n.T("block.receipt_root", h.ReceiptHash.Hex()))
+	out.Println(i18n.T("block.uncle_hash", h.UncleHash.Hex()))
+	if h.WithdrawalsHash != nil {
+		out.Println(i18n.T("block.withdrawals_root", h.WithdrawalsHash.Hex()))
+	}
+	if h.BlobGasUsed != nil {
+		out.Println(i18n.T("block.blob_gas_used", *h.BlobGasUsed))
+	}
+	if h.ExcessBlobGas != nil {
+		out.Println(i18n.T("block.excess_blob_gas", *h.ExcessBlobGas))
+	}
+	if h.ParentBeaconRoot != nil {
+		out.Println(i18n.T("block.parent_beacon_root", h.ParentBeaconRoot.Hex()))
+	}
+	if h.RequestsHash != nil {
+		out.Println(i18n.T("block.requests_hash", h.RequestsHash.Hex()))
+	}
+	out.Println(i18n.T("block.difficulty", h.Difficulty))
+	out.Println(i18n.T("block.mix_digest", h.MixDigest.Hex()))
+	out.Println(i18n.T("block.nonce", h.Nonce.Uint64()))
+	out.Println(i18n.T("block.extra", hexutil.Encode(h.Extra), printableSuffix(h.Extra)))
+}
+
+// printTxTable 以表格形式输出区块中的交易。
+func printTxTable(txs []txRecord) {
+	out.Println(i18n.T("block.tx_table_title"))
+	w := tabwriter.NewWriter(out.Stdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, i18n.T("block.tx_table_header"))
+	for _, tx := range txs {
+		to := i18n.T("block.contract_creation")
+		if tx.To != nil {
+			to = tx.To.Hex()
+		}
+		fee := "-"
+		if tx.Fee != nil {
+			fee = formatUnits(tx.Fee.Big(), 18)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t%s\t%s\n", tx.Index, tx.Hash.Hex(), tx.Type, tx.From.Hex(), to, formatUnits(tx.Value.Big(), 18), fee)
+	}
+	w.Flush()
+}
+
+// printableSuffix 在附加数据是可打印文本时返回其文本形式（很多客户端在这里写入版本号）。
+func printableSuffix(extra []byte) string {
+	if len(extra) == 0 {
+		return ""
+	}
+	for _, r := range string(extra) {
+		if r == unicode.ReplacementChar || !unicode.IsPrint(r) {
+			return ""
+		}
+	}
+	return fmt.Sprintf(" (%q)", string(extra))
+}
+
+func percent(used, limit uint64) float64 {
+	if limit == 0 {
+		return 0
+	}
+	return float64(used) * 100 / float64(limit)
+}
+
+// formatUnits 把最小单位的整数转换为带小数的字符串，例如 Wei -> ETH (decimals=18)。
+func formatUnits(amount *big.Int, decimals int) string {
+	base := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), base, new(big.Int))
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	if frac.Sign() == 0 {
+		return sign + whole.String()
+	}
+	fracStr := strings.TrimRight(fmt.Sprintf("%0*s", decimals, frac.String()), "0")
+	return sign + whole.String() + "." + fracStr
+}
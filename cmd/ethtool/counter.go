@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+)
+
+var (
+	addressFlag = &cli.StringFlag{
+		Name:  "address",
+		Usage: "Counter 合约地址，或部署记录中的名称",
+		Value: defaultDeployment,
+	}
+	newOwnerFlag = &cli.StringFlag{
+		Name:     "new-owner",
+		Usage:    "新所有者地址",
+		Required: true,
+	}
+)
+
+var counterCommand = &cli.Command{
+	Name:  "counter",
+	Usage: "与已部署的 Counter 合约交互",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "verify",
+			Usage:     "校验地址上的代码是否由 Counter 编译产物部署（忽略元数据和 immutable 变量）",
+			ArgsUsage: "[合约地址 | 部署名称]",
+			Action:    counterVerify,
+		},
+		{
+			Name:   "get",
+			Usage:  "查询当前计数",
+			Flags:  []cli.Flag{addressFlag},
+			Action: counterGet,
+		},
+		{
+			Name:   "owner",
+			Usage:  "查询合约所有者",
+			Flags:  []cli.Flag{addressFlag},
+			Action: counterOwner,
+		},
+		{
+			Name:   "inc",
+			Usage:  "调用 increment",
+			Flags:  []cli.Flag{addressFlag},
+			Action: counterTransact("increment", (*counter.CounterTransactor).Increment),
+		},
+		{
+			Name:   "dec",
+			Usage:  "调用 decrement",
+			Flags:  []cli.Flag{addressFlag},
+			Action: counterTransact("decrement", (*counter.CounterTransactor).Decrement),
+		},
+		{
+			Name:   "reset",
+			Usage:  "调用 reset（仅所有者）",
+			Flags:  []cli.Flag{addressFlag},
+			Action: counterTransact("reset", (*counter.CounterTransactor).Reset),
+		},
+		{
+			Name:   "transfer-ownership",
+			Usage:  "调用 transferOwnership，把所有权转给 --new-owner（仅所有者）",
+			Flags:  []cli.Flag{addressFlag, newOwnerFlag},
+			Action: counterTransferOwnership,
+		},
+		{
+			Name:   "renounce-ownership",
+			Usage:  "调用 renounceOwnership 放弃所有权，之后无法再调用 reset（仅所有者）",
+			Flags:  []cli.Flag{addressFlag},
+			Action: ownershipTransact("renounceOwnership", (*counter.CounterTransactor).RenounceOwnership),
+		},
+	},
+}
+
+// counterRecord 是 counter 子命令的结构化输出，只读查询时交易相关字段为 null
+type counterRecord struct {
+	Address     common.Address  `json:"address"`
+	Method      string          `json:"method"`
+	Count       *output.BigInt  `json:"count"`
+	Owner       *common.Address `json:"owner"`
+	TxHash      *common.Hash    `json:"txHash"`
+	BlockNumber *output.BigInt  `json:"blockNumber"`
+}
+
+// verifyRecord 是 counter verify 的结构化输出，代码不符时命令以退出码 11 失败
+type verifyRecord struct {
+	Address  common.Address `json:"address"`
+	CodeHash common.Hash    `json:"codeHash"`
+	CodeSize int            `json:"codeSize"`
+	Verified bool           `json:"verified"`
+}
+
+// verifyCounter 确认 address 上部署的是 Counter 合约，返回链上代码。
+func verifyCounter(ctx context.Context, s *session, address common.Address) ([]byte, error) {
+	code, err := s.client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fail(err, errs.Connection, "counter.verify_failed", address.Hex())
+	}
+	if err := counter.CounterRuntime.Match(code); err != nil {
+		return nil, fail(err, errs.CodeMismatch, "counter.verify_failed", address.Hex())
+	}
+	return code, nil
+}
+
+func counterVerify(c *cli.Context) error {
+	ref := c.Args().First()
+	if ref == "" {
+		ref = defaultDeployment
+	}
+	if err := checkContractRef(ref); err != nil {
+		return err
+	}
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	address, err := s.resolveContract(c, ref, "Counter", counterABIVersion)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+	code, err := verifyCounter(ctx, s, address)
+	if err != nil {
+		return err
+	}
+	rec := &verifyRecord{
+		Address:  address,
+		CodeHash: crypto.Keccak256Hash(code),
+		CodeSize: len(code),
+		Verified: true,
+	}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("counter.verified", address.Hex()))
+	})
+}
+
+// openCounter 连接网络，把 --address（地址或部署名称）解析为地址并绑定合约。调用方负责关闭返回的 session。
+func openCounter(c *cli.Context) (*session, *counter.Counter, common.Address, error) {
+	ref := c.String(addressFlag.Name)
+	if err := checkContractRef(ref); err != nil {
+		return nil, nil, common.Address{}, err
+	}
+	s, err := newSession(c)
+	if err != nil {
+		return nil, nil, common.Address{}, err
+	}
+	address, err := s.resolveContract(c, ref, "Counter", counterABIVersion)
+	if err != nil {
+		s.Close()
+		return nil, nil, common.Address{}, err
+	}
+	instance, err := counter.NewCounter(address, s.counterBackend())
+	if err != nil {
+		s.Close()
+		return nil, nil, common.Address{}, fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	return s, instance, address, nil
+}
+
+func counterGet(c *cli.Context) error {
+	s, instance, address, err := openCounter(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+	count, err := instance.GetCount(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fail(decodeRevert(err, counterABI()), errs.Unknown, "counter.get_failed")
+	}
+	rec := &counterRecord{
+		Address: address,
+		Method:  "getCount",
+		Count:   output.NewBigInt(count),
+	}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("counter.count", count))
+	})
+}
+
+func counterOwner(c *cli.Context) error {
+	s, instance, address, err := openCounter(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+	owner, err := instance.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fail(decodeRevert(err, counterABI()), errs.Unknown, "counter.owner_failed")
+	}
+	rec := &counterRecord{
+		Address: address,
+		Method:  "owner",
+		Owner:   &owner,
+	}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("counter.owner", owner.Hex()))
+	})
+}
+
+// counterTransact 生成调用 Counter 写方法的子命令：发送交易、等待确认，然后输出更新后的计数。
+func counterTransact(method string, call func(*counter.CounterTransactor, *bind.TransactOpts) (*types.Transaction, error)) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		return transactCounter(c, method, call, func(ctx context.Context, instance *counter.Counter, rec *counterRecord) error {
+			count, err := instance.GetCount(&bind.CallOpts{Context: ctx})
+			if err != nil {
+				return fail(decodeRevert(err, counterABI()), errs.Unknown, "counter.refresh_failed")
+			}
+			rec.Count = output.NewBigInt(count)
+			return nil
+		})
+	}
+}
+
+// ownershipTransact 同 counterTransact，但确认后输出新的所有者。
+func ownershipTransact(method string, call func(*counter.CounterTransactor, *bind.TransactOpts) (*types.Transaction, error)) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		return transactCounter(c, method, call, func(ctx context.Context, instance *counter.Counter, rec *counterRecord) error {
+			owner, err := instance.Owner(&bind.CallOpts{Context: ctx})
+			if err != nil {
+				return fail(decodeRevert(err, counterABI()), errs.Unknown, "counter.owner_failed")
+			}
+			rec.Owner = &owner
+			return nil
+		})
+	}
+}
+
+func counterTransferOwnership(c *cli.Context) error {
+	newOwner, err := parseAddress(c.String(newOwnerFlag.Name))
+	if err != nil {
+		return err
+	}
+	return ownershipTransact("transferOwnership", func(t *counter.CounterTransactor, auth *bind.TransactOpts) (*types.Transaction, error) {
+		return t.TransferOwnership(auth, newOwner)
+	})(c)
+}
+
+// transactCounter 发送交易并等待确认，再用 refresh 查询交易后的状态填入输出。
+func transactCounter(c *cli.Context, method string, call func(*counter.CounterTransactor, *bind.TransactOpts) (*types.Transaction, error), refresh func(context.Context, *counter.Counter, *counterRecord) error) error {
+	s, instance, address, err := openCounter(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, txTimeout)
+	defer cancel()
+
+	out.Println(i18n.T("counter.calling", method))
+	receipt, err := s.send(ctx, c, counterABI(), method, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return call(&instance.CounterTransactor, auth)
+	})
+	if err != nil {
+		return err
+	}
+
+	rec := &counterRecord{
+		Address:     address,
+		Method:      method,
+		TxHash:      &receipt.TxHash,
+		BlockNumber: output.NewBigInt(receipt.BlockNumber),
+	}
+	if err := refresh(ctx, instance, rec); err != nil {
+		return err
+	}
+	return out.Emit(rec, func() {
+		if rec.Owner != nil {
+			out.Println(i18n.T("counter.owner", rec.Owner.Hex()))
+		} else {
+			out.Println(i18n.T("counter.updated", rec.Count.Big()))
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/create2"
+	"github.com/clc781032855/go_ethereum/deployments"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+)
+
+var (
+	ownerFlag = &cli.StringFlag{
+		Name:        "owner",
+		Usage:       "合约初始所有者",
+		DefaultText: "签名账户",
+	}
+	create2Flag = &cli.BoolFlag{
+		Name:  "create2",
+		Usage: "通过确定性部署代理用 CREATE2 部署，地址只由 salt 和所有者决定",
+	}
+	saltFlag = &cli.StringFlag{
+		Name:        "salt",
+		Usage:       "CREATE2 salt：32 字节十六进制，或任意名称（取 keccak256）；指定时隐含 --create2",
+		DefaultText: "全零",
+	}
+	predictFlag = &cli.BoolFlag{
+		Name:  "predict",
+		Usage: "只计算 CREATE2 地址，不连接网络、不发送交易",
+	}
+	ifAbsentFlag = &cli.BoolFlag{
+		Name:  "if-absent",
+		Usage: "预测地址上已有 Counter 合约时直接复用，不再部署",
+	}
+	deploymentNameFlag = &cli.StringFlag{
+		Name:  "name",
+		Usage: "部署记录中的名称，counter 命令可以用它代替地址",
+		Value: defaultDeployment,
+	}
+)
+
+var deployCommand = &cli.Command{
+	Name:   "deploy",
+	Usage:  "部署 Counter 合约",
+	Flags:  []cli.Flag{deploymentNameFlag, ownerFlag, create2Flag, saltFlag, predictFlag, ifAbsentFlag},
+	Action: deployCounter,
+}
+
+// deployRecord 是 deploy 命令的结构化输出。复用已有合约或只预测地址时交易相关字段为 null
+type deployRecord struct {
+	Name        string          `json:"name"`
+	Address     common.Address  `json:"address"`
+	Create2     bool            `json:"create2"`
+	Salt        *common.Hash    `json:"salt"`
+	Reused      bool            `json:"reused"`
+	TxHash      *common.Hash    `json:"txHash"`
+	BlockNumber *output.BigInt  `json:"blockNumber"`
+	Owner       *common.Address `json:"owner"`
+	ExplorerURL string          `json:"explorerUrl"`
+}
+
+// deployOptions 是解析后的部署参数，owner 为 nil 时使用签名账户
+type deployOptions struct {
+	name     string
+	owner    *common.Address
+	create2  bool
+	salt     common.Hash
+	ifAbsent bool
+}
+
+func parseDeployOptions(c *cli.Context) (*deployOptions, error) {
+	opts := &deployOptions{
+		name:     c.String(deploymentNameFlag.Name),
+		create2:  c.Bool(create2Flag.Name) || c.IsSet(saltFlag.Name) || c.Bool(predictFlag.Name) || c.Bool(ifAbsentFlag.Name),
+		ifAbsent: c.Bool(ifAbsentFlag.Name),
+	}
+	if !deployments.ValidName(opts.name) {
+		return nil, usageError("deployments.bad_name", opts.name)
+	}
+	if c.IsSet(ownerFlag.Name) {
+		owner, err := parseAddress(c.String(ownerFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		opts.owner = &owner
+	}
+	salt, err := create2.ParseSalt(c.String(saltFlag.Name))
+	if err != nil {
+		return nil, fail(err, errs.Usage, "deploy.bad_salt")
+	}
+	opts.salt = salt
+	return opts, nil
+}
+
+func deployCounter(c *cli.Context) error {
+	opts, err := parseDeployOptions(c)
+	if err != nil {
+		return err
+	}
+	if c.Bool(predictFlag.Name) {
+		return predictCounter(c, opts)
+	}
+
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, txTimeout)
+	defer cancel()
+
+	owner, err := deployOwner(s, c, opts)
+	if err != nil {
+		return err
+	}
+	rec := &deployRecord{Name: opts.name, Create2: opts.create2}
+	if opts.create2 {
+		rec.Salt = &opts.salt
+		if err := deployCreate2(ctx, s, c, opts, owner, rec); err != nil {
+			return err
+		}
+	} else {
+		out.Println(i18n.T("deploy.start"))
+		receipt, err := s.send(ctx, c, counterABI(), i18n.T("deploy.name"), func(auth *bind.TransactOpts) (*types.Transaction, error) {
+			addr, tx, _, err := counter.DeployCounter(auth, s.counterBackend(), owner)
+			rec.Address = addr
+			return tx, err
+		})
+		if err != nil {
+			return err
+		}
+		rec.TxHash, rec.BlockNumber = &receipt.TxHash, output.NewBigInt(receipt.BlockNumber)
+		out.Println(i18n.T("deploy.success"))
+	}
+
+	// 确认落在链上的代码就是 Counter，再与它交互
+	code, err := verifyCounter(ctx, s, rec.Address)
+	if err != nil {
+		return err
+	}
+	out.Println(i18n.T("counter.verified", rec.Address.Hex()))
+
+	// 记录部署，之后的命令可以用名称代替地址
+	d := &deployments.Deployment{
+		Contract:     "Counter",
+		Address:      rec.Address,
+		TxHash:       rec.TxHash,
+		BytecodeHash: crypto.Keccak256Hash(code),
+		ABIVersion:   counterABIVersion,
+	}
+	if rec.BlockNumber != nil {
+		block := rec.BlockNumber.Big().Uint64()
+		d.BlockNumber = &block
+	}
+	if rec.TxHash != nil {
+		deployer := s.signer.Address()
+		d.Deployer = &deployer
+	}
+	if opts.create2 {
+		d.Salt = &opts.salt
+	}
+	if err := saveDeployment(c, s.client.VerifiedChainID.Uint64(), opts.name, d); err != nil {
+		return err
+	}
+
+	// 获取合约所有者
+	instance, err := counter.NewCounter(rec.Address, s.client)
+	if err != nil {
+		return fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	actualOwner, err := instance.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fail(decodeRevert(err, counterABI()), errs.Unknown, "counter.owner_failed")
+	}
+	rec.Owner = &actualOwner
+	rec.ExplorerURL = s.network.AddressURL(rec.Address.Hex())
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("deploy.address", rec.Address.Hex()))
+		if rec.BlockNumber != nil {
+			out.Println(i18n.T("deploy.block", rec.BlockNumber.Big()))
+		}
+		if rec.ExplorerURL != "" {
+			out.Println(i18n.T("deploy.link", rec.ExplorerURL))
+		}
+		out.Println(i18n.T("counter.owner", actualOwner.Hex()))
+	})
+}
+
+// deployOwner 返回初始所有者：--owner，未指定时为签名账户。
+func deployOwner(s *session, c *cli.Context, opts *deployOptions) (common.Address, error) {
+	if opts.owner != nil {
+		return *opts.owner, nil
+	}
+	txSigner, err := s.openSigner(c)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return txSigner.Address(), nil
+}
+
+// predictCounter 离线计算 CREATE2 地址。未指定 --owner 时需要打开签名器取得签名账户，但不连接网络。
+func predictCounter(c *cli.Context, opts *deployOptions) error {
+	owner, err := deployOwner(&session{}, c, opts)
+	if err != nil {
+		return err
+	}
+	address, err := counter.PredictCounterAddress(opts.salt, owner)
+	if err != nil {
+		return fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	rec := &deployRecord{Name: opts.name, Address: address, Create2: true, Salt: &opts.salt, Owner: &owner}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("deploy.predicted", address.Hex(), opts.salt.Hex()))
+	})
+}
+
+// deployCreate2 通过确定性部署代理部署 Counter。预测地址上已有合约时，
+// --if-absent 下校验代码后复用，否则报错（再次部署必然失败）。
+func deployCreate2(ctx context.Context, s *session, c *cli.Context, opts *deployOptions, owner common.Address, rec *deployRecord) error {
+	address, err := counter.PredictCounterAddress(opts.salt, owner)
+	if err != nil {
+		return fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	rec.Address = address
+	out.Println(i18n.T("deploy.predicted", address.Hex(), opts.salt.Hex()))
+
+	code, err := s.client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return fail(err, errs.Connection, "deploy.code_failed", address.Hex())
+	}
+	if len(code) > 0 {
+		if !opts.ifAbsent {
+			return usageError("deploy.exists", address.Hex())
+		}
+		rec.Reused = true
+		out.Println(i18n.T("deploy.reused", address.Hex()))
+		return nil
+	}
+	if err := create2.CheckProxy(ctx, s.client); err != nil {
+		return fail(err, errs.Unknown, "deploy.no_proxy", create2.ProxyAddress.Hex())
+	}
+
+	out.Println(i18n.T("deploy.start"))
+	receipt, err := s.send(ctx, c, counterABI(), i18n.T("deploy.name"), func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := counter.DeployCounterCreate2(auth, s.counterBackend(), opts.salt, owner)
+		return tx, err
+	})
+	if err != nil {
+		return err
+	}
+	rec.TxHash, rec.BlockNumber = &receipt.TxHash, output.NewBigInt(receipt.BlockNumber)
+	out.Println(i18n.T("deploy.success"))
+	return nil
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/config"
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/deployments"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+)
+
+var deploymentsFlag = &cli.StringFlag{
+	Name:        "deployments",
+	Usage:       "部署记录目录，每条链一个 <链ID>.json",
+	DefaultText: deployments.DefaultDir,
+	EnvVars:     []string{deployments.EnvDir},
+}
+
+// defaultDeployment 是 deploy 记录 Counter 时默认使用的名称，也是 counter 命令默认查找的名称
+const defaultDeployment = "Counter"
+
+var deploymentsCommand = &cli.Command{
+	Name:   "deployments",
+	Usage:  "列出所选网络上记录的部署",
+	Action: listDeployments,
+}
+
+// deploymentRecord 是 deployments 命令的结构化输出，每个部署一条记录
+type deploymentRecord struct {
+	Name           string          `json:"name"`
+	Contract       string          `json:"contract"`
+	Address        common.Address  `json:"address"`
+	TxHash         *common.Hash    `json:"txHash"`
+	BlockNumber    *uint64         `json:"blockNumber"`
+	Deployer       *common.Address `json:"deployer"`
+	BytecodeHash   common.Hash     `json:"bytecodeHash"`
+	ABIVersion     string          `json:"abiVersion"`
+	Salt           *common.Hash    `json:"salt"`
+	Implementation *common.Address `json:"implementation"`
+}
+
+// counterABIVersion 是当前 Counter 绑定的 ABI 版本
+var counterABIVersion = deployments.ABIVersion(counter.CounterMetaData.ABI)
+
+// openDeployments 按 --deployments 打开部署记录。
+func openDeployments(c *cli.Context) *deployments.Store {
+	return deployments.Open(c.String(deploymentsFlag.Name))
+}
+
+// checkContractRef 在连接网络之前校验地址或部署名称的格式。
+func checkContractRef(ref string) error {
+	if common.IsHexAddress(ref) || deployments.ValidName(ref) {
+		return nil
+	}
+	return usageError("address.invalid", ref)
+}
+
+// resolveContract 把地址或部署名称解析为合约地址。名称在当前链的部署记录中查找，
+// 记录的是合约 contract 但 ABI 版本与当前绑定 abiVersion 不同时给出提示（合约可能已重新编译但没有重新部署）。
+func (s *session) resolveContract(c *cli.Context, ref, contract, abiVersion string) (common.Address, error) {
+	if common.IsHexAddress(ref) {
+		return common.HexToAddress(ref), nil
+	}
+	if !deployments.ValidName(ref) {
+		return common.Address{}, usageError("address.invalid", ref)
+	}
+	chainID := s.client.VerifiedChainID.Uint64()
+	d, err := openDeployments(c).Get(chainID, ref)
+	if err != nil {
+		return common.Address{}, fail(err, errs.Usage, "deployments.not_found", ref, chainID)
+	}
+	out.Println(i18n.T("deployments.resolved", ref, d.Address.Hex()))
+	if d.Contract == contract && d.ABIVersion != abiVersion {
+		out.Println(i18n.T("deployments.abi_changed", ref, d.ABIVersion, abiVersion))
+	}
+	return d.Address, nil
+}
+
+// saveDeployment 记录部署。CREATE2 复用已有合约时，如果同名记录就是这个地址则保留原记录（其中有部署交易）。
+func saveDeployment(c *cli.Context, chainID uint64, name string, d *deployments.Deployment) error {
+	store := openDeployments(c)
+	if d.TxHash == nil {
+		if old, err := store.Get(chainID, name); err == nil && old.Address == d.Address {
+			return nil
+		}
+	}
+	if err := store.Save(chainID, name, d); err != nil {
+		return fail(err, errs.Unknown, "deployments.save_failed", store.Path(chainID))
+	}
+	out.Println(i18n.T("deployments.saved", name, store.Path(chainID)))
+	return nil
+}
+
+// offlineNetwork 读取所选网络的配置，不连接网络。
+func offlineNetwork(c *cli.Context) (*config.Network, error) {
+	cfg, err := config.Load(c.String(configFlag.Name))
+	if err != nil {
+		return nil, fail(err, errs.Usage, "config.load_failed")
+	}
+	network, err := cfg.Network(c.String(networkFlag.Name))
+	if err != nil {
+		return nil, fail(err, errs.Usage, "config.network_invalid")
+	}
+	return network, nil
+}
+
+// listDeployments 只读取本地记录，不连接网络，链ID取自网络配置。
+func listDeployments(c *cli.Context) error {
+	network, err := offlineNetwork(c)
+	if err != nil {
+		return err
+	}
+	store := openDeployments(c)
+	names, err := store.Names(network.ChainID)
+	if err != nil {
+		return fail(err, errs.Usage, "deployments.load_failed", store.Path(network.ChainID))
+	}
+	recs := make([]deploymentRecord, 0, len(names))
+	for _, name := range names {
+		d, err := store.Get(network.ChainID, name)
+		if err != nil {
+			return fail(err, errs.Usage, "deployments.load_failed", store.Path(network.ChainID))
+		}
+		recs = append(recs, deploymentRecord{
+			Name:           name,
+			Contract:       d.Contract,
+			Address:        d.Address,
+			TxHash:         d.TxHash,
+			BlockNumber:    d.BlockNumber,
+			Deployer:       d.Deployer,
+			BytecodeHash:   d.BytecodeHash,
+			ABIVersion:     d.ABIVersion,
+			Salt:           d.Salt,
+			Implementation: d.Implementation,
+		})
+	}
+	return out.Emit(recs, func() {
+		out.Println(i18n.T("deployments.count", len(recs), network.Name, network.ChainID))
+		for _, r := range recs {
+			out.Println(i18n.T("deployments.line", r.Name, r.Contract, r.Address.Hex(), blockText(r.BlockNumber)))
+		}
+	})
+}
+
+// blockText 格式化可能为空的区块号。
+func blockText(n *uint64) string {
+	if n == nil {
+		return "-"
+	}
+	return strconv.FormatUint(*n, 10)
+}
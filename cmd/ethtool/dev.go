@@ -0,0 +1,191 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/config"
+	"github.com/clc781032855/go_ethereum/devchain"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+// devNetwork 是开发链使用的网络配置名称，监听地址和链ID都取自这个网络
+const devNetwork = "dev"
+
+var (
+	devFlag = &cli.BoolFlag{
+		Name:    "dev",
+		Usage:   "在进程内启动本地开发链并连接到它（使用 dev 网络配置）",
+		EnvVars: []string{"ETH_DEV"},
+	}
+	devBlockTimeFlag = &cli.DurationFlag{
+		Name:        "dev-block-time",
+		Usage:       "开发链出块间隔，必须是整秒",
+		DefaultText: "收到交易立即出块",
+	}
+	devAccountsFlag = &cli.IntFlag{
+		Name:  "dev-accounts",
+		Usage: "开发链预置账户数",
+		Value: devchain.DefaultAccounts,
+	}
+)
+
+// devChain 是 --dev 启动的开发链，命令结束时关闭
+var devChain *devchain.Chain
+
+// devAccountRecord 是一个预置账户的结构化输出，余额以 Wei 为单位
+type devAccountRecord struct {
+	Index      int            `json:"index"`
+	Address    common.Address `json:"address"`
+	PrivateKey string         `json:"privateKey"`
+	Path       string         `json:"path"`
+	Balance    *output.BigInt `json:"balance"`
+}
+
+// devRecord 是 dev 命令的结构化输出
+type devRecord struct {
+	ChainID   uint64             `json:"chainId"`
+	RPCURL    string             `json:"rpcUrl"`
+	WSURL     string             `json:"wsUrl"`
+	BlockTime string             `json:"blockTime"` // 0s 表示收到交易立即出块
+	Mnemonic  string             `json:"mnemonic"`
+	Accounts  []devAccountRecord `json:"accounts"`
+}
+
+// devCommand 启动的开发链由全局参数配置，例如 ethtool --dev-block-time 2s dev
+var devCommand = &cli.Command{
+	Name:      "dev",
+	Usage:     "启动本地开发链并保持运行，其它工具可以共用它的 RPC 端点",
+	UsageText: "ethtool [--dev-block-time 间隔] [--dev-accounts 数量] dev",
+	Action:    runDev,
+}
+
+// startDev 按 dev 网络配置启动开发链，并让后续命令连接到它。
+// 预置账户来自固定助记词，未配置签名器时默认使用第一个账户。
+func startDev(c *cli.Context) error {
+	if name := c.String(networkFlag.Name); name != "" && !strings.EqualFold(name, devNetwork) {
+		return usageError("dev.network_conflict", name)
+	}
+	blockTime := c.Duration(devBlockTimeFlag.Name)
+	if blockTime < 0 || blockTime%time.Second != 0 {
+		return usageError("dev.bad_block_time", blockTime)
+	}
+	cfg, err := config.Load(c.String(configFlag.Name))
+	if err != nil {
+		return fail(err, errs.Usage, "config.load_failed")
+	}
+	network, err := cfg.Network(devNetwork)
+	if err != nil {
+		return fail(err, errs.Usage, "config.network_invalid")
+	}
+	httpAddr, err := devchain.ListenAddr(network.RPCURL)
+	if err != nil {
+		return fail(err, errs.Usage, "dev.bad_url", network.RPCURL)
+	}
+	var wsAddr string
+	if network.WSURL != "" {
+		if wsAddr, err = devchain.ListenAddr(network.WSURL); err != nil {
+			return fail(err, errs.Usage, "dev.bad_url", network.WSURL)
+		}
+	}
+
+	out.Println(i18n.T("dev.starting", httpAddr))
+	devChain, err = devchain.Start(devchain.Config{
+		ChainID:   network.ChainID,
+		HTTPAddr:  httpAddr,
+		WSAddr:    wsAddr,
+		BlockTime: blockTime,
+		Accounts:  c.Int(devAccountsFlag.Name),
+	})
+	if err != nil {
+		return fail(err, errs.Unknown, "dev.start_failed")
+	}
+	if err := c.Set(networkFlag.Name, devNetwork); err != nil {
+		return fail(err, errs.Unknown, "dev.start_failed")
+	}
+	out.Println(i18n.T("dev.started", devChain.ChainID(), devChain.HTTPEndpoint()))
+	return nil
+}
+
+// stopDev 关闭 --dev 启动的开发链，链上数据随之丢失。
+func stopDev() {
+	if devChain != nil {
+		devChain.Close()
+		devChain = nil
+	}
+}
+
+// devSignerOptions 在开发链上补全签名器配置：没有指定签名器、也没有设置私钥时，
+// 使用开发链助记词，--hd-path 选择账户（默认第一个）。
+func devSignerOptions(opts *signer.Options) {
+	if devChain == nil || opts.Kind != "" || opts.Mnemonic != "" || os.Getenv(opts.KeyEnv) != "" {
+		return
+	}
+	opts.Kind = signer.KindMnemonic
+	opts.Mnemonic = devchain.DefaultMnemonic
+}
+
+// runDev 输出开发链的端点和预置账户，然后保持运行。开发链已由 main 的 Before 启动。
+func runDev(c *cli.Context) error {
+	rec := devRecord{
+		ChainID:   devChain.ChainID(),
+		RPCURL:    devChain.HTTPEndpoint(),
+		WSURL:     devChain.WSEndpoint(),
+		BlockTime: devChain.BlockTime().String(),
+		Mnemonic:  devchain.DefaultMnemonic,
+	}
+	for i, acc := range devChain.Accounts() {
+		rec.Accounts = append(rec.Accounts, devAccountRecord{
+			Index:      i,
+			Address:    acc.Address,
+			PrivateKey: hexutil.Encode(crypto.FromECDSA(acc.Key)),
+			Path:       acc.Path,
+			Balance:    output.NewBigInt(devchain.DefaultBalance),
+		})
+	}
+
+	// CSV 无法表达嵌套结构，只输出账户表
+	var err error
+	if out.Format() == output.CSV {
+		err = out.Emit(rec.Accounts, nil)
+	} else {
+		err = out.Emit(&rec, func() {
+			if rec.BlockTime == "0s" {
+				out.Println(i18n.T("dev.mining_instant"))
+			} else {
+				out.Println(i18n.T("dev.mining_interval", rec.BlockTime))
+			}
+			out.Println(i18n.T("dev.rpc", rec.RPCURL))
+			if rec.WSURL != "" {
+				out.Println(i18n.T("dev.ws", rec.WSURL))
+			}
+			out.Println(i18n.T("dev.mnemonic", rec.Mnemonic))
+			for _, acc := range rec.Accounts {
+				out.Println(i18n.T("dev.account", acc.Index, acc.Address.Hex(), formatUnits(acc.Balance.Big(), 18)))
+				out.Println(i18n.T("dev.private_key", acc.PrivateKey))
+			}
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// 保持运行直到收到中断信号
+	ctx, stop := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	out.Println(i18n.T("dev.running"))
+	<-ctx.Done()
+	out.Println(i18n.T("dev.stopped"))
+	return nil
+}
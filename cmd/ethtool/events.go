@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+)
+
+var (
+	fromBlockFlag = &cli.Uint64Flag{
+		Name:  "from-block",
+		Usage: "起始区块",
+	}
+	toBlockFlag = &cli.Uint64Flag{
+		Name:        "to-block",
+		Usage:       "结束区块",
+		DefaultText: "最新区块",
+	}
+)
+
+var eventsCommand = &cli.Command{
+	Name:   "events",
+	Usage:  "查询 Counter 合约的 Incremented / Decremented / Reset 事件",
+	Flags:  []cli.Flag{addressFlag, fromBlockFlag, toBlockFlag},
+	Action: counterEvents,
+}
+
+// eventRecord 是 events 命令的结构化输出，每个事件一条记录
+type eventRecord struct {
+	Event       string         `json:"event"`
+	NewCount    *output.BigInt `json:"newCount"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    uint           `json:"logIndex"`
+}
+
+// counterEvent 是三种事件的统一表示，用于按链上顺序输出。
+type counterEvent struct {
+	Name     string
+	NewCount *big.Int
+	Raw      types.Log
+}
+
+func counterEvents(c *cli.Context) error {
+	s, instance, _, err := openCounter(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+
+	opts := &bind.FilterOpts{Start: c.Uint64(fromBlockFlag.Name), Context: ctx}
+	if c.IsSet(toBlockFlag.Name) {
+		end := c.Uint64(toBlockFlag.Name)
+		opts.End = &end
+	}
+
+	var events []counterEvent
+	incremented, err := instance.FilterIncremented(opts)
+	if err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Incremented")
+	}
+	for incremented.Next() {
+		events = append(events, counterEvent{"Incremented", incremented.Event.NewCount, incremented.Event.Raw})
+	}
+	if err := incremented.Error(); err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Incremented")
+	}
+
+	decremented, err := instance.FilterDecremented(opts)
+	if err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Decremented")
+	}
+	for decremented.Next() {
+		events = append(events, counterEvent{"Decremented", decremented.Event.NewCount, decremented.Event.Raw})
+	}
+	if err := decremented.Error(); err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Decremented")
+	}
+
+	reset, err := instance.FilterReset(opts)
+	if err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Reset")
+	}
+	for reset.Next() {
+		events = append(events, counterEvent{"Reset", reset.Event.NewCount, reset.Event.Raw})
+	}
+	if err := reset.Error(); err != nil {
+		return fail(err, errs.Unknown, "events.query_failed", "Reset")
+	}
+
+	// 按区块和日志序号排序，还原链上发生顺序
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Raw.BlockNumber != events[j].Raw.BlockNumber {
+			return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
+		}
+		return events[i].Raw.Index < events[j].Raw.Index
+	})
+
+	records := make([]eventRecord, len(events))
+	for i, ev := range events {
+		records[i] = eventRecord{
+			Event:       ev.Name,
+			NewCount:    output.NewBigInt(ev.NewCount),
+			BlockNumber: ev.Raw.BlockNumber,
+			BlockHash:   ev.Raw.BlockHash,
+			TxHash:      ev.Raw.TxHash,
+			LogIndex:    ev.Raw.Index,
+		}
+	}
+	return out.Emit(records, func() {
+		out.Println(i18n.T("events.count", len(events)))
+		for _, ev := range events {
+			out.Println(i18n.T("events.line", ev.Raw.BlockNumber, ev.Name, ev.NewCount, ev.Raw.TxHash.Hex()))
+		}
+	})
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/deployments"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/indexer"
+	"github.com/clc781032855/go_ethereum/output"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+// EnvIndexDir 是事件索引目录的环境变量
+const EnvIndexDir = "ETH_INDEX_DIR"
+
+var (
+	indexDirFlag = &cli.StringFlag{
+		Name:    "index-dir",
+		Usage:   "事件索引目录，每条链一个 <链ID> 子目录（LevelDB）",
+		Value:   "index",
+		EnvVars: []string{EnvIndexDir},
+	}
+	confirmationsFlag = &cli.Uint64Flag{
+		Name:        "confirmations",
+		Usage:       "只索引至少有这么多确认的区块（区块本身算 1 个，与交易确认相同），避免把可能被重组的事件写入索引",
+		DefaultText: "网络配置中的确认数",
+	}
+	chunkFlag = &cli.Uint64Flag{
+		Name:  "chunk",
+		Usage: "每次 eth_getLogs 查询的初始区块数，节点拒绝时自动减半",
+		Value: indexer.DefaultChunk,
+	}
+	maxChunkFlag = &cli.Uint64Flag{
+		Name:  "max-chunk",
+		Usage: "查询成功后放大区块数的上限",
+		Value: indexer.DefaultMaxChunk,
+	}
+	indexFromBlockFlag = &cli.Uint64Flag{
+		Name:        "from-block",
+		Usage:       "第一次索引时的起始区块，已有进度时忽略",
+		DefaultText: "部署记录中的区块，没有时为 0",
+	}
+)
+
+var indexCommand = &cli.Command{
+	Name:  "index",
+	Usage: "把 Counter 的 Incremented / Decremented / Reset 事件索引到本地数据库",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "sync",
+			Usage:  "从部署区块（或上次的进度）回填事件到最新区块，可以随时中断后继续",
+			Flags:  []cli.Flag{addressFlag, indexDirFlag, indexFromBlockFlag, toBlockFlag, confirmationsFlag, chunkFlag, maxChunkFlag},
+			Action: indexSync,
+		},
+		{
+			Name:   "events",
+			Usage:  "从本地索引查询事件，不连接网络",
+			Flags:  []cli.Flag{addressFlag, indexDirFlag, fromBlockFlag, toBlockFlag},
+			Action: indexEvents,
+		},
+	},
+}
+
+// indexSyncRecord 是 index sync 的结构化输出
+type indexSyncRecord struct {
+	Address  common.Address `json:"address"`
+	Start    uint64         `json:"start"`    // 索引覆盖的第一个区块
+	Synced   *uint64        `json:"synced"`   // 已索引到的区块，还没有索引任何区块时为 null
+	Events   int            `json:"events"`   // 本次新写入的事件数
+	Rejected int            `json:"rejected"` // 本次被节点拒绝后缩小范围的次数
+	Reorged  uint64         `json:"reorged"`  // 本次因重组回退后重新索引的区块数
+}
+
+// openIndex 打开链 chainID 的事件索引。
+func openIndex(c *cli.Context, chainID uint64) (*indexer.Store, error) {
+	dir := filepath.Join(c.String(indexDirFlag.Name), strconv.FormatUint(chainID, 10))
+	store, err := indexer.Open(dir)
+	if err != nil {
+		return nil, fail(err, errs.Usage, "index.open_failed", dir)
+	}
+	return store, nil
+}
+
+// indexStart 返回第一次索引的起始区块：--from-block，否则是部署记录中的部署区块，都没有时为 0。
+func indexStart(c *cli.Context, chainID uint64, ref string) uint64 {
+	if c.IsSet(indexFromBlockFlag.Name) {
+		return c.Uint64(indexFromBlockFlag.Name)
+	}
+	if deployments.ValidName(ref) {
+		if d, err := openDeployments(c).Get(chainID, ref); err == nil && d.BlockNumber != nil {
+			return *d.BlockNumber
+		}
+	}
+	return 0
+}
+
+func indexSync(c *cli.Context) error {
+	ref := c.String(addressFlag.Name)
+	if err := checkContractRef(ref); err != nil {
+		return err
+	}
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	address, err := s.resolveContract(c, ref, "Counter", counterABIVersion)
+	if err != nil {
+		return err
+	}
+	chainID := s.client.VerifiedChainID.Uint64()
+	store, err := openIndex(c, chainID)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	head, err := s.client.BlockNumber(ctx)
+	cancel()
+	if err != nil {
+		return fail(err, errs.Connection, "index.head_failed")
+	}
+	rec := &indexSyncRecord{Address: address}
+	ix := &indexer.Indexer{
+		Backend:  s.client,
+		Store:    store,
+		Contract: address,
+		Start:    indexStart(c, chainID, ref),
+		Chunk:    c.Uint64(chunkFlag.Name),
+		MaxChunk: c.Uint64(maxChunkFlag.Name),
+		OnProgress: func(p indexer.Progress) {
+			if p.Reorg {
+				rec.Reorged += p.To - p.From + 1
+				out.Println(i18n.T("index.reorg", p.From, p.To))
+				return
+			}
+			if p.Err != nil {
+				rec.Rejected++
+				out.Println(i18n.T("index.shrink", p.From, p.To, p.Err))
+				return
+			}
+			rec.Events += p.Events
+			out.Println(i18n.T("index.chunk", p.From, p.To, p.Events))
+		},
+	}
+
+	cp, err := store.Checkpoint(address)
+	if err != nil {
+		return fail(err, errs.Unknown, "index.read_failed")
+	}
+	if cp == nil {
+		cp = &indexer.Checkpoint{Start: ix.Start, Next: ix.Start}
+	}
+	// 确认数与交易跟踪的算法相同；链还没有足够的确认数时不索引任何区块
+	confirmations := s.network.Confirmations
+	if c.IsSet(confirmationsFlag.Name) {
+		confirmations = c.Uint64(confirmationsFlag.Name)
+	}
+	if to, ok := txtrack.ConfirmedHead(head, confirmations); ok {
+		if c.IsSet(toBlockFlag.Name) {
+			to = min(to, c.Uint64(toBlockFlag.Name))
+		}
+		// 长时间回填不设超时，中断后再次运行会从进度继续
+		cp, err = ix.Sync(c.Context, to)
+		if err != nil {
+			if cp != nil && cp.Next > cp.Start {
+				out.Println(i18n.T("index.interrupted", cp.Next-1))
+			}
+			return fail(err, errs.Connection, "index.sync_failed")
+		}
+	}
+	rec.Start = cp.Start
+	if cp.Next > cp.Start {
+		synced := cp.Next - 1
+		rec.Synced = &synced
+	}
+	return out.Emit(rec, func() {
+		if rec.Synced == nil {
+			out.Println(i18n.T("index.nothing", cp.Start))
+			return
+		}
+		out.Println(i18n.T("index.synced", address.Hex(), rec.Start, *rec.Synced, rec.Events))
+	})
+}
+
+// resolveOffline 不连接网络，把地址或部署名称解析为地址。
+func resolveOffline(c *cli.Context, chainID uint64, ref string) (common.Address, error) {
+	if err := checkContractRef(ref); err != nil {
+		return common.Address{}, err
+	}
+	if common.IsHexAddress(ref) {
+		return common.HexToAddress(ref), nil
+	}
+	d, err := openDeployments(c).Get(chainID, ref)
+	if err != nil {
+		return common.Address{}, fail(err, errs.Usage, "deployments.not_found", ref, chainID)
+	}
+	return d.Address, nil
+}
+
+func indexEvents(c *cli.Context) error {
+	network, err := offlineNetwork(c)
+	if err != nil {
+		return err
+	}
+	address, err := resolveOffline(c, network.ChainID, c.String(addressFlag.Name))
+	if err != nil {
+		return err
+	}
+	store, err := openIndex(c, network.ChainID)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	cp, err := store.Checkpoint(address)
+	if err != nil {
+		return fail(err, errs.Unknown, "index.read_failed")
+	}
+	if cp == nil || cp.Next == cp.Start {
+		return usageError("index.empty", address.Hex())
+	}
+	out.Println(i18n.T("index.range", address.Hex(), cp.Start, cp.Next-1))
+
+	to := cp.Next - 1
+	if c.IsSet(toBlockFlag.Name) {
+		to = c.Uint64(toBlockFlag.Name)
+	}
+	events, err := store.Events(address, c.Uint64(fromBlockFlag.Name), to)
+	if err != nil {
+		return fail(err, errs.Unknown, "index.read_failed")
+	}
+	records := make([]eventRecord, len(events))
+	for i, ev := range events {
+		records[i] = eventRecord{
+			Event:       ev.Name,
+			NewCount:    output.NewBigInt(ev.NewCount),
+			BlockNumber: ev.BlockNumber,
+			BlockHash:   ev.BlockHash,
+			TxHash:      ev.TxHash,
+			LogIndex:    ev.LogIndex,
+		}
+	}
+	return out.Emit(records, func() {
+		out.Println(i18n.T("events.count", len(events)))
+		for _, ev := range events {
+			out.Println(i18n.T("events.line", ev.BlockNumber, ev.Name, ev.NewCount, ev.TxHash.Hex()))
+		}
+	})
+}
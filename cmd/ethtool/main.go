@@ -0,0 +1,148 @@
+// ethtool 是与以太坊网络和 Counter 合约交互的命令行工具。
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/config"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+// EnvOutput 是选择输出格式的环境变量
+const EnvOutput = "ETH_OUTPUT"
+
+// 全局参数：网络与签名器，所有子命令共用
+var (
+	configFlag = &cli.StringFlag{
+		Name:    "config",
+		Usage:   "网络配置文件 (JSON)",
+		EnvVars: []string{config.EnvConfigFile},
+	}
+	networkFlag = &cli.StringFlag{
+		Name:    "network",
+		Aliases: []string{"n"},
+		Usage:   "网络名称，例如 mainnet、sepolia、holesky、anvil、dev",
+		EnvVars: []string{config.EnvNetwork},
+	}
+	signerFlag = &cli.StringFlag{
+		Name:    "signer",
+		Usage:   "签名器类型: env、keystore、mnemonic、remote",
+		EnvVars: []string{signer.EnvSigner},
+	}
+	keystoreFlag = &cli.StringFlag{
+		Name:    "keystore",
+		Usage:   "keystore JSON 文件路径",
+		EnvVars: []string{signer.EnvKeystore},
+	}
+	passwordFileFlag = &cli.StringFlag{
+		Name:    "password-file",
+		Usage:   "keystore 密码文件",
+		EnvVars: []string{signer.EnvKeystorePassFile},
+	}
+	hdPathFlag = &cli.StringFlag{
+		Name:    "hd-path",
+		Usage:   "助记词派生路径",
+		Value:   signer.DefaultHDPath,
+		EnvVars: []string{signer.EnvHDPath},
+	}
+	signerURLFlag = &cli.StringFlag{
+		Name:    "signer-url",
+		Usage:   "远程签名器 (Clef) 地址",
+		EnvVars: []string{signer.EnvSignerURL},
+	}
+	fromFlag = &cli.StringFlag{
+		Name:    "from",
+		Usage:   "远程签名器使用的账户地址",
+		EnvVars: []string{signer.EnvSignerAddress},
+	}
+	langFlag = &cli.StringFlag{
+		Name:        "lang",
+		Usage:       "输出语言: zh、en",
+		DefaultText: "按 " + i18n.EnvLang + " / LANG 选择",
+	}
+	outputFlag = &cli.StringFlag{
+		Name:    "output",
+		Aliases: []string{"o"},
+		Usage:   "输出格式: " + strings.Join(formatNames(), "、"),
+		Value:   string(output.Human),
+		EnvVars: []string{EnvOutput},
+	}
+)
+
+// out 是所有子命令共用的输出，由 --output 选择格式
+var out = output.New(output.Human, os.Stdout, os.Stderr)
+
+func formatNames() []string {
+	names := make([]string, len(output.Formats))
+	for i, f := range output.Formats {
+		names[i] = string(f)
+	}
+	return names
+}
+
+func main() {
+	app := &cli.App{
+		Name:  "ethtool",
+		Usage: "以太坊网络与 Counter 合约交互工具",
+		Description: "退出码: 1 未分类错误, 2 参数/配置错误, 3 连接失败, 4 链不匹配, 5 签名失败, " +
+			"6 余额不足, 7 nonce 错误, 8 手续费过低, 9 执行回滚, 10 超时, 11 合约代码不符",
+		Flags: []cli.Flag{
+			configFlag,
+			networkFlag,
+			signerFlag,
+			keystoreFlag,
+			passwordFileFlag,
+			hdPathFlag,
+			signerURLFlag,
+			fromFlag,
+			outputFlag,
+			langFlag,
+			deploymentsFlag,
+			devFlag,
+			devBlockTimeFlag,
+			devAccountsFlag,
+		},
+		Before: func(c *cli.Context) error {
+			lang, err := i18n.Detect(c.String(langFlag.Name))
+			if err != nil {
+				return errs.Wrap(err, errs.Usage, "")
+			}
+			i18n.SetLang(lang)
+			format, err := output.ParseFormat(c.String(outputFlag.Name))
+			if err != nil {
+				return errs.Wrap(err, errs.Usage, "")
+			}
+			out = output.New(format, os.Stdout, os.Stderr)
+			if c.Bool(devFlag.Name) || c.Args().First() == devCommand.Name {
+				return startDev(c)
+			}
+			return nil
+		},
+		After: func(*cli.Context) error {
+			stopDev()
+			return nil
+		},
+		Commands: []*cli.Command{
+			blockCommand,
+			sendCommand,
+			deployCommand,
+			counterCommand,
+			eventsCommand,
+			indexCommand,
+			deploymentsCommand,
+			proxyCommand,
+			devCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		log.Print(i18n.T("app.failed", err))
+		os.Exit(errs.ExitCode(err))
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+	"github.com/clc781032855/go_ethereum/deployments"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+	"github.com/clc781032855/go_ethereum/upgradeable"
+)
+
+// defaultProxyDeployment 是可升级 Counter 代理的默认部署名称
+const defaultProxyDeployment = "CounterProxy"
+
+var (
+	proxyNameFlag = &cli.StringFlag{
+		Name:  "name",
+		Usage: "部署记录中的名称，proxy 和 counter 命令可以用它代替代理地址",
+		Value: defaultProxyDeployment,
+	}
+	proxyAddressFlag = &cli.StringFlag{
+		Name:  "address",
+		Usage: "代理合约地址，或部署记录中的名称",
+		Value: defaultProxyDeployment,
+	}
+	implementationFlag = &cli.StringFlag{
+		Name:        "implementation",
+		Usage:       "新实现合约地址",
+		DefaultText: "部署当前编译产物",
+	}
+)
+
+var proxyCommand = &cli.Command{
+	Name:  "proxy",
+	Usage: "部署和升级 ERC-1967 代理后面的可升级 Counter（counter 命令可以直接操作代理地址）",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "deploy",
+			Usage:  "部署 CounterUpgradeable 实现合约和指向它的代理，并以 --owner 初始化",
+			Flags:  []cli.Flag{proxyNameFlag, ownerFlag},
+			Action: proxyDeploy,
+		},
+		{
+			Name:   "upgrade",
+			Usage:  "把代理升级到 --implementation，未指定时先部署新的实现合约（仅所有者）",
+			Flags:  []cli.Flag{proxyAddressFlag, implementationFlag},
+			Action: proxyUpgrade,
+		},
+		{
+			Name:   "implementation",
+			Usage:  "读取代理 ERC-1967 实现槽中的当前实现合约",
+			Flags:  []cli.Flag{proxyAddressFlag},
+			Action: proxyImplementation,
+		},
+	},
+}
+
+// proxyRecord 是 proxy 子命令的结构化输出，只读查询时交易相关字段为 null
+type proxyRecord struct {
+	Name                   string          `json:"name"`
+	Proxy                  common.Address  `json:"proxy"`
+	Implementation         common.Address  `json:"implementation"`
+	PreviousImplementation *common.Address `json:"previousImplementation"`
+	Owner                  *common.Address `json:"owner"`
+	TxHash                 *common.Hash    `json:"txHash"`
+	BlockNumber            *output.BigInt  `json:"blockNumber"`
+}
+
+// upgradeableABI 返回解析后的 CounterUpgradeable ABI，它包含 Counter 的全部错误和升级相关的错误。
+var upgradeableABI = sync.OnceValue(func() *abi.ABI {
+	parsed, err := upgradeable.CounterUpgradeableMetaData.GetAbi()
+	if err != nil {
+		panic(i18n.T("abi.parse_failed", err))
+	}
+	return parsed
+})
+
+// upgradeableABIVersion 是当前 CounterUpgradeable 绑定的 ABI 版本
+var upgradeableABIVersion = deployments.ABIVersion(upgradeable.CounterUpgradeableMetaData.ABI)
+
+// verifyRuntime 确认 address 上部署的是编译产物 runtime（合约名 name），返回链上代码。
+func verifyRuntime(ctx context.Context, s *session, address common.Address, name string, runtime *bytecode.Runtime) ([]byte, error) {
+	code, err := s.client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fail(err, errs.Connection, "counter.verify_failed", address.Hex())
+	}
+	if err := runtime.Match(code); err != nil {
+		return nil, fail(err, errs.CodeMismatch, "counter.verify_failed", address.Hex())
+	}
+	out.Println(i18n.T("proxy.verified", address.Hex(), name))
+	return code, nil
+}
+
+// deployImplementation 部署 CounterUpgradeable 实现合约并校验代码。
+func deployImplementation(ctx context.Context, s *session, c *cli.Context) (common.Address, error) {
+	var address common.Address
+	out.Println(i18n.T("proxy.deploying_impl"))
+	_, err := s.send(ctx, c, upgradeableABI(), i18n.T("proxy.impl_name"), func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		addr, tx, _, err := upgradeable.DeployCounterUpgradeable(auth, s.contractBackend(upgradeableABI()))
+		address = addr
+		return tx, err
+	})
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, err := verifyRuntime(ctx, s, address, "CounterUpgradeable", upgradeable.CounterUpgradeableRuntime); err != nil {
+		return common.Address{}, err
+	}
+	return address, nil
+}
+
+// readImplementation 读取代理的实现槽。
+func readImplementation(ctx context.Context, s *session, proxy common.Address) (common.Address, error) {
+	impl, err := upgradeable.Implementation(ctx, s.client, proxy, nil)
+	if err != nil {
+		return common.Address{}, fail(err, errs.Connection, "proxy.slot_failed", proxy.Hex())
+	}
+	return impl, nil
+}
+
+func proxyDeploy(c *cli.Context) error {
+	name := c.String(proxyNameFlag.Name)
+	if !deployments.ValidName(name) {
+		return usageError("deployments.bad_name", name)
+	}
+	opts := &deployOptions{name: name}
+	if c.IsSet(ownerFlag.Name) {
+		owner, err := parseAddress(c.String(ownerFlag.Name))
+		if err != nil {
+			return err
+		}
+		opts.owner = &owner
+	}
+
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(c.Context, txTimeout)
+	defer cancel()
+
+	owner, err := deployOwner(s, c, opts)
+	if err != nil {
+		return err
+	}
+	impl, err := deployImplementation(ctx, s, c)
+	if err != nil {
+		return err
+	}
+
+	rec := &proxyRecord{Name: name, Implementation: impl}
+	out.Println(i18n.T("proxy.deploying_proxy"))
+	receipt, err := s.send(ctx, c, upgradeableABI(), i18n.T("proxy.proxy_name"), func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		addr, tx, _, err := upgradeable.DeployCounterProxy(auth, s.contractBackend(upgradeableABI()), impl, owner)
+		rec.Proxy = addr
+		return tx, err
+	})
+	if err != nil {
+		return err
+	}
+	rec.TxHash, rec.BlockNumber = &receipt.TxHash, output.NewBigInt(receipt.BlockNumber)
+	code, err := verifyRuntime(ctx, s, rec.Proxy, "ERC1967Proxy", upgradeable.ERC1967ProxyRuntime)
+	if err != nil {
+		return err
+	}
+
+	// 记录代理地址：之后的 counter 和 proxy 命令都可以用名称代替地址
+	block := receipt.BlockNumber.Uint64()
+	deployer := s.signer.Address()
+	d := &deployments.Deployment{
+		Contract:       "CounterUpgradeable",
+		Address:        rec.Proxy,
+		TxHash:         rec.TxHash,
+		BlockNumber:    &block,
+		Deployer:       &deployer,
+		BytecodeHash:   crypto.Keccak256Hash(code),
+		ABIVersion:     upgradeableABIVersion,
+		Implementation: &impl,
+	}
+	if err := saveDeployment(c, s.client.VerifiedChainID.Uint64(), name, d); err != nil {
+		return err
+	}
+
+	instance, err := upgradeable.NewCounterUpgradeable(rec.Proxy, s.client)
+	if err != nil {
+		return fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	actualOwner, err := instance.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fail(decodeRevert(err, upgradeableABI()), errs.Unknown, "counter.owner_failed")
+	}
+	rec.Owner = &actualOwner
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("proxy.address", rec.Proxy.Hex()))
+		out.Println(i18n.T("proxy.implementation", rec.Implementation.Hex()))
+		out.Println(i18n.T("deploy.block", rec.BlockNumber.Big()))
+		out.Println(i18n.T("counter.owner", actualOwner.Hex()))
+	})
+}
+
+// openProxy 连接网络，把 --address（地址或部署名称）解析为代理地址并确认它是 ERC1967Proxy。
+// 调用方负责关闭返回的 session。
+func openProxy(ctx context.Context, c *cli.Context) (*session, common.Address, error) {
+	ref := c.String(proxyAddressFlag.Name)
+	if err := checkContractRef(ref); err != nil {
+		return nil, common.Address{}, err
+	}
+	s, err := newSession(c)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	address, err := s.resolveContract(c, ref, "CounterUpgradeable", upgradeableABIVersion)
+	if err != nil {
+		s.Close()
+		return nil, common.Address{}, err
+	}
+	if _, err := verifyRuntime(ctx, s, address, "ERC1967Proxy", upgradeable.ERC1967ProxyRuntime); err != nil {
+		s.Close()
+		return nil, common.Address{}, err
+	}
+	return s, address, nil
+}
+
+func proxyUpgrade(c *cli.Context) error {
+	var next *common.Address
+	if c.IsSet(implementationFlag.Name) {
+		addr, err := parseAddress(c.String(implementationFlag.Name))
+		if err != nil {
+			return err
+		}
+		next = &addr
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context, txTimeout)
+	defer cancel()
+
+	s, proxy, err := openProxy(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	previous, err := readImplementation(ctx, s, proxy)
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		impl, err := deployImplementation(ctx, s, c)
+		if err != nil {
+			return err
+		}
+		next = &impl
+	}
+
+	instance, err := upgradeable.NewCounterUpgradeable(proxy, s.contractBackend(upgradeableABI()))
+	if err != nil {
+		return fail(err, errs.Unknown, "counter.bind_failed")
+	}
+	out.Println(i18n.T("proxy.upgrading", proxy.Hex(), next.Hex()))
+	receipt, err := s.send(ctx, c, upgradeableABI(), "upgradeToAndCall", func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return instance.UpgradeToAndCall(auth, *next, nil)
+	})
+	if err != nil {
+		return err
+	}
+	impl, err := readImplementation(ctx, s, proxy)
+	if err != nil {
+		return err
+	}
+
+	// 名称指向的部署记录随之更新
+	ref := c.String(proxyAddressFlag.Name)
+	if !common.IsHexAddress(ref) {
+		chainID := s.client.VerifiedChainID.Uint64()
+		store := openDeployments(c)
+		d, err := store.Get(chainID, ref)
+		if err != nil {
+			return fail(err, errs.Usage, "deployments.load_failed", store.Path(chainID))
+		}
+		d.Implementation = &impl
+		if err := store.Save(chainID, ref, d); err != nil {
+			return fail(err, errs.Unknown, "deployments.save_failed", store.Path(chainID))
+		}
+		out.Println(i18n.T("deployments.saved", ref, store.Path(chainID)))
+	}
+
+	rec := &proxyRecord{
+		Name:                   ref,
+		Proxy:                  proxy,
+		Implementation:         impl,
+		PreviousImplementation: &previous,
+		TxHash:                 &receipt.TxHash,
+		BlockNumber:            output.NewBigInt(receipt.BlockNumber),
+	}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("proxy.upgraded", previous.Hex(), impl.Hex()))
+	})
+}
+
+func proxyImplementation(c *cli.Context) error {
+	ctx, cancel := context.WithTimeout(c.Context, callTimeout)
+	defer cancel()
+
+	s, proxy, err := openProxy(ctx, c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	impl, err := readImplementation(ctx, s, proxy)
+	if err != nil {
+		return err
+	}
+	rec := &proxyRecord{Name: c.String(proxyAddressFlag.Name), Proxy: proxy, Implementation: impl}
+	return out.Emit(rec, func() {
+		out.Println(i18n.T("proxy.implementation", impl.Hex()))
+	})
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/output"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+var (
+	toFlag = &cli.StringFlag{
+		Name:     "to",
+		Usage:    "接收方地址",
+		Required: true,
+	}
+	valueFlag = &cli.StringFlag{
+		Name:  "value",
+		Usage: "转账金额 (Wei)",
+		Value: "1000000000000000", // 0.001 ETH
+	}
+)
+
+// sendRecord 是 send 命令的结构化输出，金额和手续费以 Wei 为单位
+type sendRecord struct {
+	TxHash      common.Hash    `json:"txHash"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *output.BigInt `json:"value"`
+	Nonce       uint64         `json:"nonce"`
+	GasLimit    uint64         `json:"gasLimit"`
+	GasUsed     uint64         `json:"gasUsed"`
+	Fee         *output.BigInt `json:"fee"`
+	BlockNumber *output.BigInt `json:"blockNumber"`
+	ExplorerURL string         `json:"explorerUrl"`
+}
+
+var sendCommand = &cli.Command{
+	Name:   "send",
+	Usage:  "发送 ETH 转账交易",
+	Flags:  []cli.Flag{toFlag, valueFlag},
+	Action: sendTransfer,
+}
+
+func sendTransfer(c *cli.Context) error {
+	// 先校验参数，参数错误时不必连接网络
+	receiverAddr, err := parseAddress(c.String(toFlag.Name))
+	if err != nil {
+		return err
+	}
+	amount, ok := new(big.Int).SetString(c.String(valueFlag.Name), 10)
+	if !ok || amount.Sign() < 0 {
+		return usageError("send.bad_value", c.String(valueFlag.Name))
+	}
+
+	s, err := newSession(c)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	out.Println(i18n.T("send.start"))
+	txSigner, err := s.openSigner(c)
+	if err != nil {
+		return err
+	}
+	senderAddress := txSigner.Address()
+	out.Println(i18n.T("send.to", receiverAddr.Hex()))
+	out.Println(i18n.T("send.value", amount.String()))
+
+	txCtx, cancel := context.WithTimeout(c.Context, txTimeout)
+	defer cancel()
+
+	// 分配 nonce、获取手续费、估算 gas、签名并广播，使用连接时已校验过的链ID
+	signedTx, fees, err := txtrack.SendTransfer(txCtx, s.client, s.nonces, txSigner, s.client.VerifiedChainID, txtrack.Transfer{
+		To:       receiverAddr,
+		Value:    amount,
+		Fees:     s.fees,
+		Estimate: gas.EstimateConfig{MarginPercent: s.network.GasMarginPercent, Cap: s.network.GasCap},
+	})
+	if err != nil {
+		return transferFailure(err)
+	}
+	if fees.Legacy {
+		out.Println(i18n.T("tx.legacy_fees", fees.GasPrice))
+	} else {
+		out.Println(i18n.T("tx.dynamic_fees", fees.BaseFee, fees.GasTipCap, fees.GasFeeCap))
+	}
+	gasLimit := signedTx.Gas()
+	out.Println(i18n.T("send.gas_limit", gasLimit))
+
+	// 输出交易哈希
+	txHash := signedTx.Hash().Hex()
+	link := s.network.TxURL(txHash)
+	out.Println(i18n.T("send.success"))
+	out.Println(i18n.T("send.hash", txHash))
+	if link != "" {
+		out.Println(i18n.T("tx.link", link))
+	}
+
+	// 跟踪交易直到达到网络要求的确认数，长时间未打包时自动加速一次
+	receipt, err := s.tracker().Track(txCtx, signedTx)
+	if err != nil {
+		if errors.Is(err, txtrack.ErrReverted) {
+			return fail(err, errs.Reverted, "send.reverted")
+		}
+		return fail(err, errs.Unknown, "send.wait_failed")
+	}
+
+	// 自动加速后最终上链的可能是替换交易
+	return out.Emit(&sendRecord{
+		TxHash:      receipt.TxHash,
+		From:        senderAddress,
+		To:          receiverAddr,
+		Value:       output.NewBigInt(amount),
+		Nonce:       signedTx.Nonce(),
+		GasLimit:    gasLimit,
+		GasUsed:     receipt.GasUsed,
+		Fee:         output.NewBigInt(receiptFee(receipt)),
+		BlockNumber: output.NewBigInt(receipt.BlockNumber),
+		ExplorerURL: s.network.TxURL(receipt.TxHash.Hex()),
+	}, nil)
+}
+
+// transferFailure 按失败的步骤给转账错误分类
+func transferFailure(err error) error {
+	var te *txtrack.TransferError
+	if !errors.As(err, &te) {
+		return fail(err, errs.Unknown, "send.send_failed")
+	}
+	switch te.Step {
+	case txtrack.StepNonce:
+		return fail(te.Err, errs.Connection, "tx.nonce_failed")
+	case txtrack.StepFees:
+		return fail(te.Err, errs.Connection, "tx.fees_failed")
+	case txtrack.StepEstimate:
+		return fail(te.Err, errs.Unknown, "send.estimate_failed")
+	case txtrack.StepSign:
+		return fail(te.Err, errs.Signing, "send.sign_failed")
+	default:
+		return fail(te.Err, errs.Unknown, "send.send_failed")
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli/v2"
+
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/config"
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/i18n"
+	"github.com/clc781032855/go_ethereum/nonce"
+	"github.com/clc781032855/go_ethereum/revert"
+	"github.com/clc781032855/go_ethereum/signer"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+// 超时设置
+const (
+	dialTimeout = 10 * time.Second // 连接并校验链身份
+	callTimeout = 10 * time.Second // 只读查询
+	txTimeout   = 15 * time.Minute // 发送交易并等待确认
+)
+
+// session 是子命令共用的连接、签名器和 nonce 管理器。
+type session struct {
+	network *config.Network
+	client  *chain.Conn
+	signer  signer.Signer
+	nonces  *nonce.Manager
+	fees    gas.FeeConfig
+}
+
+// newSession 读取配置，连接所选网络并校验链身份。
+func newSession(c *cli.Context) (*session, error) {
+	cfg, err := config.Load(c.String(configFlag.Name))
+	if err != nil {
+		return nil, fail(err, errs.Usage, "config.load_failed")
+	}
+	network, err := cfg.Network(c.String(networkFlag.Name))
+	if err != nil {
+		return nil, fail(err, errs.Usage, "config.network_invalid")
+	}
+	feeMode, err := gas.ParseFeeMode(network.FeeMode)
+	if err != nil {
+		return nil, fail(err, errs.Usage, "config.fee_mode_invalid")
+	}
+
+	// 连接到所选网络，并校验链ID和创世区块，不匹配时直接退出，不会进入签名流程
+	out.Println(i18n.T("connect.start", network.Name))
+	ctx, cancel := context.WithTimeout(c.Context, dialTimeout)
+	defer cancel()
+	client, err := chain.Dial(ctx, network)
+	if err != nil {
+		return nil, fail(err, errs.Connection, "connect.failed", network.Name)
+	}
+	out.Println(i18n.T("connect.success", network.Name, client.VerifiedChainID))
+
+	return &session{
+		network: network,
+		client:  client,
+		nonces:  nonce.NewManager(),
+		fees:    gas.FeeConfig{Mode: feeMode, MaxFeeMultiplier: network.MaxFeeMultiplier},
+	}, nil
+}
+
+// Close 关闭连接，有请求因限流等待过时输出统计。
+func (s *session) Close() {
+	for _, st := range s.client.Stats() {
+		if st.Throttled > 0 {
+			out.Println(i18n.T("rpc.throttled", st.URL, st.Throttled, st.Requests, st.ThrottledWait.Round(time.Millisecond)))
+		}
+	}
+	s.client.Close()
+}
+
+// openSigner 按全局参数打开签名器，私钥不写在源码里。
+func (s *session) openSigner(c *cli.Context) (signer.Signer, error) {
+	if s.signer != nil {
+		return s.signer, nil
+	}
+	opts, err := signer.OptionsFromEnv()
+	if err != nil {
+		return nil, fail(err, errs.Usage, "signer.config_invalid")
+	}
+	opts.Kind = c.String(signerFlag.Name)
+	opts.KeystoreFile = c.String(keystoreFlag.Name)
+	opts.HDPath = c.String(hdPathFlag.Name)
+	opts.RemoteURL = c.String(signerURLFlag.Name)
+	devSignerOptions(&opts)
+	if file := c.String(passwordFileFlag.Name); file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fail(err, errs.Usage, "signer.password_file_failed")
+		}
+		opts.Password = strings.TrimRight(string(data), "\r\n")
+	}
+	if from := c.String(fromFlag.Name); from != "" {
+		if opts.RemoteAddress, err = parseAddress(from); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context, dialTimeout)
+	defer cancel()
+	s.signer, err = signer.Open(ctx, opts)
+	if err != nil {
+		return nil, fail(err, errs.Signing, "signer.open_failed")
+	}
+	out.Println(i18n.T("signer.address", s.signer.Address().Hex()))
+	return s.signer, nil
+}
+
+// transactOpts 创建合约交易选项：手续费按网络配置计算，gas limit 交给 counterBackend 估算。
+func (s *session) transactOpts(ctx context.Context, c *cli.Context) (*bind.TransactOpts, error) {
+	txSigner, err := s.openSigner(c)
+	if err != nil {
+		return nil, err
+	}
+	auth := signer.TransactOpts(ctx, txSigner, s.client.VerifiedChainID)
+	fees, err := gas.SuggestFees(ctx, s.client, s.fees)
+	if err != nil {
+		return nil, fail(err, errs.Connection, "tx.fees_failed")
+	}
+	if fees.Legacy {
+		auth.GasPrice = fees.GasPrice
+	} else {
+		auth.GasTipCap, auth.GasFeeCap = fees.GasTipCap, fees.GasFeeCap
+	}
+	auth.GasLimit = 0 // 由 eth_estimateGas 估算（加安全余量）
+	return auth, nil
+}
+
+// counterBackend 返回 Counter 绑定使用的后端：gas limit 由估算值加安全余量得到，
+// 估算失败（例如调用会回滚）时给出解码后的原因。
+func (s *session) counterBackend() bind.ContractBackend {
+	return s.contractBackend(counterABI())
+}
+
+// contractBackend 同 counterBackend，但按 contractABI 解码回滚原因。
+func (s *session) contractBackend(contractABI *abi.ABI) bind.ContractBackend {
+	return gas.WithEstimator(s.client, gas.EstimateConfig{
+		MarginPercent: s.network.GasMarginPercent,
+		Cap:           s.network.GasCap,
+		ABI:           contractABI,
+	})
+}
+
+// tracker 创建交易跟踪器：等待网络要求的确认数，长时间未打包时自动加速一次。
+// 加速需要签名器，调用前必须已经 openSigner。
+func (s *session) tracker() *txtrack.Tracker {
+	return txtrack.New(s.client, s.signer, s.client.VerifiedChainID, txtrack.Options{
+		Confirmations: s.network.Confirmations,
+		AutoSpeedUp:   1,
+		Fees:          s.fees,
+		OnUpdate:      printTxUpdate,
+	})
+}
+
+// send 分配 nonce 并通过 bind 发送交易：发送失败时释放 nonce，成功后等待确认。
+// 估算或执行失败时按 contractABI 解码回滚原因。
+func (s *session) send(ctx context.Context, c *cli.Context, contractABI *abi.ABI, name string, transact func(auth *bind.TransactOpts) (*types.Transaction, error)) (*types.Receipt, error) {
+	auth, err := s.transactOpts(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	reservation, err := s.nonces.Reserve(ctx, s.client, s.client.VerifiedChainID, auth.From)
+	if err != nil {
+		return nil, fail(err, errs.Connection, "tx.nonce_failed")
+	}
+	auth.Nonce = reservation.BigInt()
+	tx, err := transact(auth)
+	if err != nil {
+		reservation.Abandon(err)
+		return nil, fail(decodeRevert(err, contractABI), errs.Unknown, "tx.call_failed", name)
+	}
+	reservation.Commit()
+	out.Println(i18n.T("tx.sent", name, tx.Hash().Hex()))
+	if link := s.network.TxURL(tx.Hash().Hex()); link != "" {
+		out.Println(i18n.T("tx.link", link))
+	}
+
+	out.Println(i18n.T("tx.waiting"))
+	receipt, err := s.tracker().Track(ctx, tx)
+	if err != nil {
+		if errors.Is(err, txtrack.ErrReverted) {
+			return nil, fail(s.explainRevert(ctx, tx, err, contractABI), errs.Reverted, "tx.reverted", name)
+		}
+		return nil, fail(err, errs.Unknown, "tx.wait_failed", name)
+	}
+	return receipt, nil
+}
+
+// explainRevert 在失败交易所在的区块上重放交易，按 contractABI 解码回滚原因。
+func (s *session) explainRevert(ctx context.Context, tx *types.Transaction, err error, contractABI *abi.ABI) error {
+	var reverted *txtrack.RevertedError
+	if !errors.As(err, &reverted) {
+		return err
+	}
+	reason, replayErr := revert.Replay(ctx, s.client, tx, reverted.Receipt, contractABI)
+	if replayErr != nil {
+		return err
+	}
+	return reason
+}
+
+// counterABI 返回解析后的 Counter ABI。ABI 是编译进绑定代码的，解析失败说明绑定代码损坏。
+var counterABI = sync.OnceValue(func() *abi.ABI {
+	parsed, err := counter.CounterMetaData.GetAbi()
+	if err != nil {
+		panic(i18n.T("abi.parse_failed", err))
+	}
+	return parsed
+})
+
+// decodeRevert 从 eth_call / eth_estimateGas 的错误中按 contractABI 解码回滚原因。
+func decodeRevert(err error, contractABI *abi.ABI) error {
+	if reason, ok := revert.FromError(err, contractABI); ok {
+		return reason
+	}
+	return err
+}
+
+// parseAddress 解析十六进制地址。
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, usageError("address.invalid", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// fail 用本地化消息包装 err，消息模板的最后一个参数是 err 本身。
+// 无法从 err 识别出分类时使用 kind。
+func fail(err error, kind errs.Kind, key string, args ...any) error {
+	return errs.Wrap(err, kind, i18n.T(key, append(args, err)...))
+}
+
+// usageError 创建参数错误。
+func usageError(key string, args ...any) error {
+	return errs.New(errs.Usage, i18n.T(key, args...))
+}
+
+// printTxUpdate 输出交易跟踪进度
+func printTxUpdate(u txtrack.Update) {
+	switch u.Status {
+	case txtrack.StatusPending:
+		out.Println(i18n.T("tx.pending", u.Tx.Hash().Hex()))
+	case txtrack.StatusStuck:
+		out.Println(i18n.T("tx.stuck", u.Tx.Hash().Hex()))
+	case txtrack.StatusReplaced:
+		out.Println(i18n.T("tx.replaced", u.Tx.Hash().Hex()))
+	case txtrack.StatusMined:
+		out.Println(i18n.T("tx.mined", u.Receipt.BlockNumber, u.Confirmations))
+	case txtrack.StatusConfirmed:
+		out.Println(i18n.T("tx.confirmed", u.Receipt.BlockNumber, u.Confirmations))
+	case txtrack.StatusReorged:
+		out.Println(i18n.T("tx.reorged", u.Tx.Hash().Hex()))
+	case txtrack.StatusSupplanted:
+		out.Println(i18n.T("tx.supplanted", u.Tx.Hash().Hex()))
+	}
+}
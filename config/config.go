@@ -0,0 +1,343 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 环境变量名
+const (
+	EnvConfigFile       = "ETH_CONFIG"             // 配置文件路径
+	EnvNetwork          = "ETH_NETWORK"            // 选择的网络名称
+	EnvRPCURL           = "ETH_RPC_URL"            // 覆盖所选网络的 RPC 地址
+	EnvWSURL            = "ETH_WS_URL"             // 覆盖所选网络的 WebSocket 地址
+	EnvFallbackRPCURLs  = "ETH_FALLBACK_RPC_URLS"  // 覆盖所选网络的备用 RPC 地址，逗号分隔
+	EnvChainID          = "ETH_CHAIN_ID"           // 覆盖所选网络的链ID
+	EnvExplorerURL      = "ETH_EXPLORER_URL"       // 覆盖所选网络的区块浏览器地址
+	EnvFeeMode          = "ETH_FEE_MODE"           // 覆盖交易类型：auto / dynamic / legacy
+	EnvMaxFeeMultiplier = "ETH_MAX_FEE_MULTIPLIER" // 覆盖 maxFeePerGas 相对 baseFee 的倍数
+	EnvRPCRate          = "ETH_RPC_RPS"            // 覆盖每个节点每秒请求数上限
+	EnvRPCComputeRate   = "ETH_RPC_CUPS"           // 覆盖每个节点每秒计算单元上限
+)
+
+// DefaultConfigFile 是未设置 ETH_CONFIG 时尝试读取的配置文件。
+const DefaultConfigFile = "config.json"
+
+// Network 描述一个命名网络的连接参数。
+// URL 中可以使用 ${VAR} 引用环境变量，避免把 API Key 写进仓库。
+type Network struct {
+	Name   string `json:"name"`
+	RPCURL string `json:"rpcUrl"`
+	WSURL  string `json:"wsUrl,omitempty"`
+	// 备用 RPC 地址，主节点不可用时只读调用会依次切换过去
+	FallbackRPCURLs []string `json:"fallbackRpcUrls,omitempty"`
+	ChainID         uint64   `json:"chainId"`
+	GenesisHash     string   `json:"genesisHash,omitempty"` // 可选，配置后连接时会校验创世区块哈希
+	ExplorerURL     string   `json:"explorerUrl,omitempty"`
+
+	FeeMode          string  `json:"feeMode,omitempty"`          // auto（默认）、dynamic 或 legacy（未启用 London 的链）
+	MaxFeeMultiplier float64 `json:"maxFeeMultiplier,omitempty"` // maxFeePerGas 相对 baseFee 的倍数
+	Confirmations    uint64  `json:"confirmations,omitempty"`    // 交易被视为最终确认所需的区块数
+	GasMarginPercent uint64  `json:"gasMarginPercent,omitempty"` // 在 eth_estimateGas 结果上增加的余量（百分比）
+	GasCap           uint64  `json:"gasCap,omitempty"`           // gas limit 上限，默认使用最新区块的 gas limit
+
+	RateLimit *RateLimit `json:"rateLimit,omitempty"` // 每个 RPC 节点各自的限流，未配置时不限流
+}
+
+// RateLimit 是单个 RPC 节点的令牌桶限流配置，0 表示该项不限制。
+// 计算单元（compute units）按方法计费，与 Alchemy 等服务商的计费方式一致。
+type RateLimit struct {
+	RequestsPerSecond     float64 `json:"requestsPerSecond,omitempty"`
+	ComputeUnitsPerSecond float64 `json:"computeUnitsPerSecond,omitempty"`
+}
+
+// Config 是网络注册表。
+type Config struct {
+	DefaultNetwork string              `json:"defaultNetwork"`
+	Networks       map[string]*Network `json:"networks"`
+}
+
+// Defaults 返回内置的网络配置。
+func Defaults() *Config {
+	return &Config{
+		DefaultNetwork: "sepolia",
+		Networks: map[string]*Network{
+			"mainnet": {
+				RPCURL:        "https://mainnet.infura.io/v3/${INFURA_API_KEY}",
+				WSURL:         "wss://mainnet.infura.io/ws/v3/${INFURA_API_KEY}",
+				ChainID:       1,
+				GenesisHash:   "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+				ExplorerURL:   "https://etherscan.io",
+				Confirmations: 3,
+			},
+			"sepolia": {
+				RPCURL:        "https://sepolia.infura.io/v3/${INFURA_API_KEY}",
+				WSURL:         "wss://sepolia.infura.io/ws/v3/${INFURA_API_KEY}",
+				ChainID:       11155111,
+				GenesisHash:   "0x25a5cc106eea7138acab33231d7160d69cb777ee0c2c553fcddf5138993e6dd9",
+				ExplorerURL:   "https://sepolia.etherscan.io",
+				Confirmations: 2,
+			},
+			"holesky": {
+				RPCURL:        "https://holesky.infura.io/v3/${INFURA_API_KEY}",
+				WSURL:         "wss://holesky.infura.io/ws/v3/${INFURA_API_KEY}",
+				ChainID:       17000,
+				GenesisHash:   "0xb5f7f912443c940f21fd611f12828d75b534364ed9e95ca4e307729a4661bde4",
+				ExplorerURL:   "https://holesky.etherscan.io",
+				Confirmations: 2,
+			},
+			"anvil": {
+				RPCURL:  "http://127.0.0.1:8545",
+				WSURL:   "ws://127.0.0.1:8545",
+				ChainID: 31337,
+			},
+			"dev": {
+				RPCURL:  "http://127.0.0.1:8545",
+				WSURL:   "ws://127.0.0.1:8546",
+				ChainID: 1337,
+			},
+		},
+	}
+}
+
+// Load 读取配置：内置默认值 <- 配置文件 <- 环境变量。
+// path 为空时使用 ETH_CONFIG，仍为空则尝试当前目录下的 config.json（不存在不报错）。
+func Load(path string) (*Config, error) {
+	cfg := Defaults()
+
+	optional := false
+	if path == "" {
+		path = os.Getenv(EnvConfigFile)
+	}
+	if path == "" {
+		path, optional = DefaultConfigFile, true
+	}
+	if err := cfg.mergeFile(path); err != nil {
+		if !(optional && errors.Is(err, os.ErrNotExist)) {
+			return nil, err
+		}
+	}
+	for name, n := range cfg.Networks {
+		n.Name = name
+	}
+	return cfg, nil
+}
+
+// mergeFile 把配置文件中的网络合并进来，同名网络按字段覆盖。
+func (c *Config) mergeFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var file Config
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if file.DefaultNetwork != "" {
+		c.DefaultNetwork = file.DefaultNetwork
+	}
+	for name, n := range file.Networks {
+		if n == nil {
+			continue
+		}
+		name = strings.ToLower(name)
+		base, ok := c.Networks[name]
+		if !ok {
+			c.Networks[name] = n
+			continue
+		}
+		if n.RPCURL != "" {
+			base.RPCURL = n.RPCURL
+		}
+		if n.WSURL != "" {
+			base.WSURL = n.WSURL
+		}
+		if len(n.FallbackRPCURLs) > 0 {
+			base.FallbackRPCURLs = n.FallbackRPCURLs
+		}
+		if n.ChainID != 0 {
+			base.ChainID = n.ChainID
+		}
+		if n.GenesisHash != "" {
+			base.GenesisHash = n.GenesisHash
+		}
+		if n.ExplorerURL != "" {
+			base.ExplorerURL = n.ExplorerURL
+		}
+		if n.FeeMode != "" {
+			base.FeeMode = n.FeeMode
+		}
+		if n.MaxFeeMultiplier != 0 {
+			base.MaxFeeMultiplier = n.MaxFeeMultiplier
+		}
+		if n.Confirmations != 0 {
+			base.Confirmations = n.Confirmations
+		}
+		if n.GasMarginPercent != 0 {
+			base.GasMarginPercent = n.GasMarginPercent
+		}
+		if n.GasCap != 0 {
+			base.GasCap = n.GasCap
+		}
+		if n.RateLimit != nil {
+			base.RateLimit = n.RateLimit
+		}
+	}
+	return nil
+}
+
+// Names 返回所有已配置的网络名称（已排序）。
+func (c *Config) Names() []string {
+	names := make([]string, 0, len(c.Networks))
+	for name := range c.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Network 返回解析后的网络配置。name 为空时依次使用 ETH_NETWORK 和 defaultNetwork。
+// 返回值是副本，已应用 ETH_RPC_URL 等环境变量覆盖并展开 ${VAR}。
+func (c *Config) Network(name string) (*Network, error) {
+	if name == "" {
+		name = os.Getenv(EnvNetwork)
+	}
+	if name == "" {
+		name = c.DefaultNetwork
+	}
+	name = strings.ToLower(name)
+	base, ok := c.Networks[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q (available: %s)", name, strings.Join(c.Names(), ", "))
+	}
+	n := *base
+	n.Name = name
+
+	if v := os.Getenv(EnvRPCURL); v != "" {
+		n.RPCURL = v
+	}
+	if v := os.Getenv(EnvWSURL); v != "" {
+		n.WSURL = v
+	}
+	if v := os.Getenv(EnvFallbackRPCURLs); v != "" {
+		n.FallbackRPCURLs = strings.Split(v, ",")
+	}
+	if v := os.Getenv(EnvExplorerURL); v != "" {
+		n.ExplorerURL = v
+	}
+	if v := os.Getenv(EnvChainID); v != "" {
+		id, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", EnvChainID, v, err)
+		}
+		n.ChainID = id
+	}
+	if v := os.Getenv(EnvFeeMode); v != "" {
+		n.FeeMode = v
+	}
+	if v := os.Getenv(EnvMaxFeeMultiplier); v != "" {
+		m, err := strconv.ParseFloat(v, 64)
+		if err != nil || m <= 0 {
+			return nil, fmt.Errorf("invalid %s %q", EnvMaxFeeMultiplier, v)
+		}
+		n.MaxFeeMultiplier = m
+	}
+	if err := n.applyRateLimitEnv(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if n.RPCURL, err = expand(n.RPCURL); err != nil {
+		return nil, fmt.Errorf("network %s rpcUrl: %w", name, err)
+	}
+	if n.WSURL, err = expand(n.WSURL); err != nil {
+		return nil, fmt.Errorf("network %s wsUrl: %w", name, err)
+	}
+	fallbacks := make([]string, 0, len(n.FallbackRPCURLs))
+	for _, u := range n.FallbackRPCURLs {
+		if u = strings.TrimSpace(u); u == "" {
+			continue
+		}
+		if u, err = expand(u); err != nil {
+			return nil, fmt.Errorf("network %s fallbackRpcUrls: %w", name, err)
+		}
+		fallbacks = append(fallbacks, u)
+	}
+	n.FallbackRPCURLs = fallbacks
+	if n.RPCURL == "" {
+		return nil, fmt.Errorf("network %s has no rpcUrl", name)
+	}
+	return &n, nil
+}
+
+// applyRateLimitEnv 应用限流相关的环境变量。RateLimit 是指针，修改前先复制，不影响注册表。
+func (n *Network) applyRateLimitEnv() error {
+	rps, cups := os.Getenv(EnvRPCRate), os.Getenv(EnvRPCComputeRate)
+	if rps == "" && cups == "" {
+		return nil
+	}
+	limit := RateLimit{}
+	if n.RateLimit != nil {
+		limit = *n.RateLimit
+	}
+	for _, item := range []struct {
+		env, value string
+		dst        *float64
+	}{
+		{EnvRPCRate, rps, &limit.RequestsPerSecond},
+		{EnvRPCComputeRate, cups, &limit.ComputeUnitsPerSecond},
+	} {
+		if item.value == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(item.value, 64)
+		if err != nil || v < 0 {
+			return fmt.Errorf("invalid %s %q", item.env, item.value)
+		}
+		*item.dst = v
+	}
+	n.RateLimit = &limit
+	return nil
+}
+
+// RPCURLs 返回主 RPC 地址和备用地址，按故障转移顺序排列。
+func (n *Network) RPCURLs() []string {
+	return append([]string{n.RPCURL}, n.FallbackRPCURLs...)
+}
+
+// expand 展开 ${VAR}，引用了未设置的环境变量时报错，而不是拼出一个残缺的 URL。
+func expand(s string) (string, error) {
+	var missing []string
+	out := os.Expand(s, func(key string) string {
+		v, ok := os.LookupEnv(key)
+		if !ok || v == "" {
+			missing = append(missing, key)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("environment variable %s is not set", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
+
+// TxURL 返回交易在区块浏览器中的链接，未配置浏览器时返回空字符串。
+func (n *Network) TxURL(hash string) string {
+	if n.ExplorerURL == "" {
+		return ""
+	}
+	return strings.TrimRight(n.ExplorerURL, "/") + "/tx/" + hash
+}
+
+// AddressURL 返回地址在区块浏览器中的链接，未配置浏览器时返回空字符串。
+func (n *Network) AddressURL(addr string) string {
+	if n.ExplorerURL == "" {
+		return ""
+	}
+	return strings.TrimRight(n.ExplorerURL, "/") + "/address/" + addr
+}
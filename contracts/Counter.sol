@@ -1,5 +1,5 @@
 // SPDX-License-Identifier: MIT
-pragma solidity ^0.8.0;
+pragma solidity ^0.8.4;
 
 contract Counter {
     uint256 private count;
@@ -9,11 +9,28 @@ contract Counter {
     event Incremented(uint256 newCount);
     event Decremented(uint256 newCount);
     event Reset(uint256 newCount);
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
 
-    // 构造函数
-    constructor() {
-        owner = msg.sender;
+    // 错误声明
+    error OwnableUnauthorizedAccount(address account);
+    error OwnableInvalidOwner(address owner);
+
+    // 只有所有者可以调用
+    modifier onlyOwner() {
+        if (msg.sender != owner) {
+            revert OwnableUnauthorizedAccount(msg.sender);
+        }
+        _;
+    }
+
+    // 构造函数，initialOwner 是初始所有者。通过 CREATE2 工厂部署时 msg.sender 是工厂，
+    // 因此所有者必须显式传入；它也是创建字节码的一部分，决定了 CREATE2 地址
+    constructor(address initialOwner) {
+        if (initialOwner == address(0)) {
+            revert OwnableInvalidOwner(address(0));
+        }
         count = 0;
+        _transferOwnership(initialOwner);
     }
 
     // 获取当前计数
@@ -34,9 +51,28 @@ contract Counter {
         emit Decremented(count);
     }
 
-    // 重置计数
-    function reset() public {
+    // 重置计数，只有所有者可以调用
+    function reset() public onlyOwner {
         count = 0;
         emit Reset(count);
     }
+
+    // 把所有权转给 newOwner，不能是零地址
+    function transferOwnership(address newOwner) public onlyOwner {
+        if (newOwner == address(0)) {
+            revert OwnableInvalidOwner(address(0));
+        }
+        _transferOwnership(newOwner);
+    }
+
+    // 放弃所有权，之后再也无法调用 reset
+    function renounceOwnership() public onlyOwner {
+        _transferOwnership(address(0));
+    }
+
+    function _transferOwnership(address newOwner) private {
+        address previousOwner = owner;
+        owner = newOwner;
+        emit OwnershipTransferred(previousOwner, newOwner);
+    }
 }
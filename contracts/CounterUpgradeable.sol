@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+// CounterUpgradeable 是部署在 ERC-1967 代理后面的 Counter（UUPS）。
+// 状态保存在代理中，升级只替换实现合约，地址和计数都不变。
+// 初始化函数代替构造函数设置所有者；升级由所有者通过 upgradeToAndCall 发起。
+contract CounterUpgradeable {
+    // keccak256("eip1967.proxy.implementation") - 1
+    bytes32 internal constant IMPLEMENTATION_SLOT = 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc;
+
+    // 实现合约自身的地址，用于区分直接调用和经代理的 delegatecall
+    address private immutable __self = address(this);
+
+    // 存储布局只能在末尾追加，升级时不能调整已有变量的顺序和类型
+    bool private _initialized;
+    uint256 private count;
+    address public owner;
+
+    // 事件声明
+    event Incremented(uint256 newCount);
+    event Decremented(uint256 newCount);
+    event Reset(uint256 newCount);
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+    event Initialized();
+    event Upgraded(address indexed implementation);
+
+    // 错误声明
+    error OwnableUnauthorizedAccount(address account);
+    error OwnableInvalidOwner(address owner);
+    error InvalidInitialization();
+    error UUPSUnauthorizedCallContext();
+    error UUPSUnsupportedProxiableUUID(bytes32 slot);
+    error ERC1967InvalidImplementation(address implementation);
+
+    // 只有所有者可以调用
+    modifier onlyOwner() {
+        if (msg.sender != owner) {
+            revert OwnableUnauthorizedAccount(msg.sender);
+        }
+        _;
+    }
+
+    // 只能经由指向本实现的代理调用
+    modifier onlyProxy() {
+        if (address(this) == __self || _getImplementation() != __self) {
+            revert UUPSUnauthorizedCallContext();
+        }
+        _;
+    }
+
+    // 只能直接调用实现合约
+    modifier notDelegated() {
+        if (address(this) != __self) {
+            revert UUPSUnauthorizedCallContext();
+        }
+        _;
+    }
+
+    // 锁定实现合约本身，只有代理可以初始化
+    constructor() {
+        _initialized = true;
+    }
+
+    // 初始化，代替构造函数，只能调用一次
+    function initialize(address initialOwner) public {
+        if (_initialized) {
+            revert InvalidInitialization();
+        }
+        if (initialOwner == address(0)) {
+            revert OwnableInvalidOwner(address(0));
+        }
+        _initialized = true;
+        _transferOwnership(initialOwner);
+        emit Initialized();
+    }
+
+    // 获取当前计数
+    function getCount() public view returns (uint256) {
+        return count;
+    }
+
+    // 增加计数
+    function increment() public {
+        count += 1;
+        emit Incremented(count);
+    }
+
+    // 减少计数
+    function decrement() public {
+        require(count > 0, "Count cannot be negative");
+        count -= 1;
+        emit Decremented(count);
+    }
+
+    // 重置计数，只有所有者可以调用
+    function reset() public onlyOwner {
+        count = 0;
+        emit Reset(count);
+    }
+
+    // 把所有权转给 newOwner，不能是零地址
+    function transferOwnership(address newOwner) public onlyOwner {
+        if (newOwner == address(0)) {
+            revert OwnableInvalidOwner(address(0));
+        }
+        _transferOwnership(newOwner);
+    }
+
+    // 放弃所有权，之后再也无法调用 reset 和升级
+    function renounceOwnership() public onlyOwner {
+        _transferOwnership(address(0));
+    }
+
+    // ERC-1822：新实现必须返回相同的槽位，防止升级到不支持 UUPS 的合约后再也无法升级
+    function proxiableUUID() external view notDelegated returns (bytes32) {
+        return IMPLEMENTATION_SLOT;
+    }
+
+    // 升级到 newImplementation，data 非空时在新实现上 delegatecall 它（例如迁移函数）
+    function upgradeToAndCall(address newImplementation, bytes calldata data) external onlyProxy onlyOwner {
+        // 没有代码的地址调用成功但没有返回值，try/catch 捕获不到解码失败，必须先检查
+        if (newImplementation.code.length == 0) {
+            revert ERC1967InvalidImplementation(newImplementation);
+        }
+        try CounterUpgradeable(newImplementation).proxiableUUID() returns (bytes32 slot) {
+            if (slot != IMPLEMENTATION_SLOT) {
+                revert UUPSUnsupportedProxiableUUID(slot);
+            }
+        } catch {
+            revert ERC1967InvalidImplementation(newImplementation);
+        }
+        assembly {
+            sstore(IMPLEMENTATION_SLOT, newImplementation)
+        }
+        emit Upgraded(newImplementation);
+        if (data.length > 0) {
+            (bool ok, bytes memory result) = newImplementation.delegatecall(data);
+            if (!ok) {
+                assembly {
+                    revert(add(result, 32), mload(result))
+                }
+            }
+        }
+    }
+
+    function _getImplementation() private view returns (address implementation) {
+        assembly {
+            implementation := sload(IMPLEMENTATION_SLOT)
+        }
+    }
+
+    function _transferOwnership(address newOwner) private {
+        address previousOwner = owner;
+        owner = newOwner;
+        emit OwnershipTransferred(previousOwner, newOwner);
+    }
+}
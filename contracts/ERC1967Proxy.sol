@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.4;
+
+// ERC1967Proxy 把所有调用 delegatecall 到 ERC-1967 实现槽中的合约。
+// 代理本身没有升级逻辑，升级由实现合约（UUPS）负责改写槽位。
+contract ERC1967Proxy {
+    // keccak256("eip1967.proxy.implementation") - 1
+    bytes32 internal constant IMPLEMENTATION_SLOT = 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc;
+
+    event Upgraded(address indexed implementation);
+
+    error ERC1967InvalidImplementation(address implementation);
+
+    // 设置实现合约，data 非空时在实现上 delegatecall 它完成初始化，与部署在同一笔交易中
+    constructor(address implementation, bytes memory data) payable {
+        if (implementation.code.length == 0) {
+            revert ERC1967InvalidImplementation(implementation);
+        }
+        assembly {
+            sstore(IMPLEMENTATION_SLOT, implementation)
+        }
+        emit Upgraded(implementation);
+        if (data.length > 0) {
+            (bool ok, bytes memory result) = implementation.delegatecall(data);
+            if (!ok) {
+                assembly {
+                    revert(add(result, 32), mload(result))
+                }
+            }
+        }
+    }
+
+    fallback() external payable {
+        assembly {
+            let implementation := sload(IMPLEMENTATION_SLOT)
+            calldatacopy(0, 0, calldatasize())
+            let ok := delegatecall(gas(), implementation, 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            switch ok
+            case 0 {
+                revert(0, returndatasize())
+            }
+            default {
+                return(0, returndatasize())
+            }
+        }
+    }
+}
@@ -31,8 +31,8 @@ var (
 
 // CounterMetaData contains all meta data concerning the Counter contract.
 var CounterMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Decremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Incremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Reset\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"decrement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"increment\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
-	Bin: "0x608060405234801561001057600080fd5b5061016d806100206000396000f3fe6080604052348015600f57600080fd5b506004361060325760003560e01c806306661abd1460375780633fb5c1cb14605157806342966c6814606b57806360fe47b11460855780637de7882114609f575b600080fd5b60405160485960200160485980600052602060002090603f0190600055505050565b6000805460405190605190605a01905550565b60405160485960200160485980600052602060002090603f01906000555050565b60405160485960200160485980600052602060002090603f01906000555050565b60405160485960200160485980600052602060002090603f01906000555050565b6000805460405190605190605a01905550565b600080805460405190605190605a01905550565b600080805460405190605190605a01905550565b60006020828403121560e157600080fd5b81356001600160a01b0381111560f057600080fd5b836040516020016040518360005260206000208460405190605190605a019055806001600160a01b0316604051602001604051908101604052810190508051806000555056fea26469706673582212202a4e0e828c4e3c5502fefd8a5a3e86e2e9a3232d13b9b8ff3c343271d9e0d20064736f6c63430008000033",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"initialOwner\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Decremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Incremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Reset\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"decrement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"increment\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b506040516104bc3803806104bc833981016040819052602b9160b7565b6001600160a01b038116605757604051631e4fbdf760e01b81525f600482015260240160405180910390fd5b5f80556061816066565b5060e2565b600180546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b5f6020828403121560c6575f5ffd5b81516001600160a01b038116811460db575f5ffd5b9392505050565b6103cd806100ef5f395ff3fe608060405234801561000f575f5ffd5b506004361061007a575f3560e01c8063a87d942c11610058578063a87d942c146100c0578063d09de08a146100d0578063d826f88f146100d8578063f2fde38b146100e0575f5ffd5b80632baeceb71461007e578063715018a6146100885780638da5cb5b14610090575b5f5ffd5b6100866100f3565b005b610086610196565b6001546100a3906001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b5f546040519081526020016100b7565b6100866101ce565b610086610216565b6100866100ee36600461032a565b610277565b5f5f54116101485760405162461bcd60e51b815260206004820152601860248201527f436f756e742063616e6e6f74206265206e65676174697665000000000000000060448201526064015b60405180910390fd5b60015f5f828254610159919061036b565b90915550505f546040519081527fc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a906020015b60405180910390a1565b6001546001600160a01b031633146101c35760405163118cdaa760e01b815233600482015260240161013f565b6101cc5f6102d9565b565b60015f5f8282546101df9190610384565b90915550505f546040519081527f20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d849060200161018c565b6001546001600160a01b031633146102435760405163118cdaa760e01b815233600482015260240161013f565b5f8080556040519081527f01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf69060200161018c565b6001546001600160a01b031633146102a45760405163118cdaa760e01b815233600482015260240161013f565b6001600160a01b0381166102cd57604051631e4fbdf760e01b81525f600482015260240161013f565b6102d6816102d9565b50565b600180546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b5f6020828403121561033a575f5ffd5b81356001600160a01b0381168114610350575f5ffd5b9392505050565b634e487b7160e01b5f52601160045260245ffd5b8181038181111561037e5761037e610357565b92915050565b8082018082111561037e5761037e61035756fea264697066735822122095a6009963023ba4939f09b3b6fd0666276c8eff2376052242891fc1e5cf328a64736f6c634300081e0033",
 }
 
 // CounterABI is the input ABI used to generate the binding from.
@@ -44,7 +44,7 @@ var CounterABI = CounterMetaData.ABI
 var CounterBin = CounterMetaData.Bin
 
 // DeployCounter deploys a new Ethereum contract, binding an instance of Counter to it.
-func DeployCounter(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Counter, error) {
+func DeployCounter(auth *bind.TransactOpts, backend bind.ContractBackend, initialOwner common.Address) (common.Address, *types.Transaction, *Counter, error) {
 	parsed, err := CounterMetaData.GetAbi()
 	if err != nil {
 		return common.Address{}, nil, nil, err
@@ -53,7 +53,7 @@ func DeployCounter(auth *bind.TransactOpts, backend bind.ContractBackend) (commo
 		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
 	}
 
-	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CounterBin), backend)
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CounterBin), backend, initialOwner)
 	if err != nil {
 		return common.Address{}, nil, nil, err
 	}
@@ -306,6 +306,27 @@ func (_Counter *CounterTransactorSession) Increment() (*types.Transaction, error
 	return _Counter.Contract.Increment(&_Counter.TransactOpts)
 }
 
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Counter *CounterTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Counter.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Counter *CounterSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Counter.Contract.RenounceOwnership(&_Counter.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_Counter *CounterTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _Counter.Contract.RenounceOwnership(&_Counter.TransactOpts)
+}
+
 // Reset is a paid mutator transaction binding the contract method 0xd826f88f.
 //
 // Solidity: function reset() returns()
@@ -327,6 +348,27 @@ func (_Counter *CounterTransactorSession) Reset() (*types.Transaction, error) {
 	return _Counter.Contract.Reset(&_Counter.TransactOpts)
 }
 
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Counter *CounterTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _Counter.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Counter *CounterSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Counter.Contract.TransferOwnership(&_Counter.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_Counter *CounterTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _Counter.Contract.TransferOwnership(&_Counter.TransactOpts, newOwner)
+}
+
 // CounterDecrementedIterator is returned from FilterDecremented and is used to iterate over the raw logs and unpacked data for Decremented events raised by the Counter contract.
 type CounterDecrementedIterator struct {
 	Event *CounterDecremented // Event containing the contract specifics and raw log
@@ -595,6 +637,159 @@ func (_Counter *CounterFilterer) ParseIncremented(log types.Log) (*CounterIncrem
 	return event, nil
 }
 
+// CounterOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Counter contract.
+type CounterOwnershipTransferredIterator struct {
+	Event *CounterOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterOwnershipTransferred represents a OwnershipTransferred event raised by the Counter contract.
+type CounterOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Counter *CounterFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CounterOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Counter.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterOwnershipTransferredIterator{contract: _Counter.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Counter *CounterFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CounterOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _Counter.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterOwnershipTransferred)
+				if err := _Counter.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_Counter *CounterFilterer) ParseOwnershipTransferred(log types.Log) (*CounterOwnershipTransferred, error) {
+	event := new(CounterOwnershipTransferred)
+	if err := _Counter.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // CounterResetIterator is returned from FilterReset and is used to iterate over the raw logs and unpacked data for Reset events raised by the Counter contract.
 type CounterResetIterator struct {
 	Event *CounterReset // Event containing the contract specifics and raw log
@@ -0,0 +1,473 @@
+package counter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+	"github.com/clc781032855/go_ethereum/create2"
+	"github.com/clc781032855/go_ethereum/internal/simtest"
+	"github.com/clc781032855/go_ethereum/revert"
+)
+
+// fixture 是部署在模拟链上的 Counter 合约
+type fixture struct {
+	sim     *simulated.Backend
+	auth    *bind.TransactOpts // 部署者，也是初始所有者
+	other   *bind.TransactOpts // 另一个有余额的账户
+	address common.Address
+	counter *Counter
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	auth, other := simtest.NewAccount(t), simtest.NewAccount(t)
+	sim := simtest.NewBackend(t, types.GenesisAlloc{
+		create2.ProxyAddress: {Code: create2.ProxyCode, Balance: new(big.Int)},
+	}, auth.From, other.From)
+
+	address, tx, c, err := DeployCounter(auth, sim.Client(), auth.From)
+	if err != nil {
+		t.Fatalf("DeployCounter: %v", err)
+	}
+	simtest.Mine(t, sim, tx)
+	return &fixture{sim: sim, auth: auth, other: other, address: address, counter: c}
+}
+
+// transact 以部署者身份发送交易并出块。
+func (f *fixture) transact(t *testing.T, fn func(*bind.TransactOpts) (*types.Transaction, error)) *types.Receipt {
+	t.Helper()
+	return simtest.Transact(t, f.sim, f.auth, fn)
+}
+
+// transactAs 以 auth 的身份发送交易并出块。
+func (f *fixture) transactAs(t *testing.T, auth *bind.TransactOpts, fn func(*bind.TransactOpts) (*types.Transaction, error)) *types.Receipt {
+	t.Helper()
+	return simtest.Transact(t, f.sim, auth, fn)
+}
+
+func (f *fixture) count(t *testing.T) int64 {
+	t.Helper()
+	n, err := f.counter.GetCount(&bind.CallOpts{})
+	if err != nil {
+		t.Fatalf("GetCount: %v", err)
+	}
+	return n.Int64()
+}
+
+func TestDeploy(t *testing.T) {
+	f := newFixture(t)
+	if err := CounterRuntime.Verify(context.Background(), f.sim.Client(), f.address); err != nil {
+		t.Fatalf("deployed code does not match artifact: %v", err)
+	}
+	if got := f.count(t); got != 0 {
+		t.Errorf("initial count = %d; want 0", got)
+	}
+	owner, err := f.counter.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatalf("Owner: %v", err)
+	}
+	if owner != f.auth.From {
+		t.Errorf("owner = %s; want deployer %s", owner.Hex(), f.auth.From.Hex())
+	}
+}
+
+func TestDeployCreate2(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	salt, err := create2.ParseSalt("counter-v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	predicted, err := PredictCounterAddress(salt, f.other.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 由 auth 发送交易、以 other 为所有者部署，所有者不是 msg.sender（代理）
+	address, tx, c, err := DeployCounterCreate2(f.auth, f.sim.Client(), salt, f.other.From)
+	if err != nil {
+		t.Fatalf("DeployCounterCreate2: %v", err)
+	}
+	simtest.Mine(t, f.sim, tx)
+	if address != predicted {
+		t.Fatalf("deployed at %s; predicted %s", address.Hex(), predicted.Hex())
+	}
+	if err := CounterRuntime.Verify(ctx, f.sim.Client(), address); err != nil {
+		t.Fatal(err)
+	}
+	owner, err := c.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != f.other.From {
+		t.Errorf("owner = %s; want %s", owner.Hex(), f.other.From.Hex())
+	}
+
+	// 同一 salt 和所有者再次部署会失败；换一个所有者得到不同地址
+	if _, _, _, err := DeployCounterCreate2(f.auth, f.sim.Client(), salt, f.other.From); err == nil {
+		t.Error("second deployment to the same address succeeded")
+	}
+	if other, _ := PredictCounterAddress(salt, f.auth.From); other == predicted {
+		t.Error("different owners predicted the same address")
+	}
+}
+
+// 元数据不同（例如源码注释改变）的代码仍然视为同一合约，其余字节不同则不是
+func TestVerify(t *testing.T) {
+	var (
+		otherMetadata = common.HexToAddress("0x1000")
+		patched       = common.HexToAddress("0x2000")
+		eoa           = common.HexToAddress("0x3000")
+	)
+	stripped := bytecode.StripMetadata(CounterRuntime.Code)
+	withMetadata := append([]byte{}, CounterRuntime.Code...)
+	withMetadata[len(stripped)+10] ^= 0xff // 元数据中的源码哈希
+	withPatch := append([]byte{}, CounterRuntime.Code...)
+	withPatch[len(stripped)-1] ^= 0xff
+
+	sim := simtest.NewBackend(t, types.GenesisAlloc{
+		otherMetadata: {Code: withMetadata, Balance: new(big.Int)},
+		patched:       {Code: withPatch, Balance: new(big.Int)},
+	}, eoa)
+
+	ctx := context.Background()
+	if err := CounterRuntime.Verify(ctx, sim.Client(), otherMetadata); err != nil {
+		t.Errorf("code with different metadata: %v", err)
+	}
+	if err := CounterRuntime.Verify(ctx, sim.Client(), patched); !errors.Is(err, bytecode.ErrMismatch) {
+		t.Errorf("patched code: err = %v; want ErrMismatch", err)
+	}
+	if err := CounterRuntime.Verify(ctx, sim.Client(), eoa); !errors.Is(err, bytecode.ErrNoCode) {
+		t.Errorf("account without code: err = %v; want ErrNoCode", err)
+	}
+}
+
+func TestIncrementDecrementReset(t *testing.T) {
+	f := newFixture(t)
+
+	f.transact(t, f.counter.Increment)
+	f.transact(t, f.counter.Increment)
+	if got := f.count(t); got != 2 {
+		t.Fatalf("count after two increments = %d; want 2", got)
+	}
+	f.transact(t, f.counter.Decrement)
+	if got := f.count(t); got != 1 {
+		t.Fatalf("count after decrement = %d; want 1", got)
+	}
+	f.transact(t, f.counter.Reset)
+	if got := f.count(t); got != 0 {
+		t.Fatalf("count after reset = %d; want 0", got)
+	}
+}
+
+func TestDecrementBelowZeroReverts(t *testing.T) {
+	f := newFixture(t)
+	// GasLimit 为 0 时绑定会先估算 gas，回滚在估算阶段就会暴露
+	_, err := f.counter.Decrement(f.auth)
+	if err == nil {
+		t.Fatal("Decrement at zero succeeded; want revert")
+	}
+	reason, ok := revert.FromError(err, nil)
+	if !ok {
+		t.Fatalf("error %v carries no revert data", err)
+	}
+	if reason.Kind != revert.KindError || reason.Reason != "Count cannot be negative" {
+		t.Errorf("reverted with %v; want Error(\"Count cannot be negative\")", reason)
+	}
+}
+
+// expectRevert 检查 err 是合约的自定义错误 name，返回错误参数。
+func expectRevert(t *testing.T, err error, name string) []interface{} {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("call succeeded; want %s", name)
+	}
+	contractABI, abiErr := CounterMetaData.GetAbi()
+	if abiErr != nil {
+		t.Fatal(abiErr)
+	}
+	reason, ok := revert.FromError(err, contractABI)
+	if !ok {
+		t.Fatalf("error %v carries no revert data; want %s", err, name)
+	}
+	if reason.Kind != revert.KindCustom || reason.Name != name {
+		t.Fatalf("reverted with %v; want %s", reason, name)
+	}
+	return reason.Args
+}
+
+func TestFilterEvents(t *testing.T) {
+	f := newFixture(t)
+	inc := f.transact(t, f.counter.Increment)
+	f.transact(t, f.counter.Increment)
+	dec := f.transact(t, f.counter.Decrement)
+	reset := f.transact(t, f.counter.Reset)
+
+	opts := &bind.FilterOpts{Start: 0, Context: context.Background()}
+
+	incs, err := f.counter.FilterIncremented(opts)
+	if err != nil {
+		t.Fatalf("FilterIncremented: %v", err)
+	}
+	defer incs.Close()
+	var got []int64
+	for incs.Next() {
+		got = append(got, incs.Event.NewCount.Int64())
+	}
+	if err := incs.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Incremented counts = %v; want [1 2]", got)
+	}
+
+	decs, err := f.counter.FilterDecremented(opts)
+	if err != nil {
+		t.Fatalf("FilterDecremented: %v", err)
+	}
+	defer decs.Close()
+	if !decs.Next() {
+		t.Fatalf("no Decremented event: %v", decs.Error())
+	}
+	if decs.Event.NewCount.Int64() != 1 || decs.Event.Raw.TxHash != dec.TxHash {
+		t.Errorf("Decremented = %d in %s; want 1 in %s", decs.Event.NewCount, decs.Event.Raw.TxHash.Hex(), dec.TxHash.Hex())
+	}
+	if decs.Next() {
+		t.Error("unexpected second Decremented event")
+	}
+
+	resets, err := f.counter.FilterReset(opts)
+	if err != nil {
+		t.Fatalf("FilterReset: %v", err)
+	}
+	defer resets.Close()
+	if !resets.Next() {
+		t.Fatalf("no Reset event: %v", resets.Error())
+	}
+	if resets.Event.NewCount.Sign() != 0 || resets.Event.Raw.BlockNumber != reset.BlockNumber.Uint64() {
+		t.Errorf("Reset = %d in block %d; want 0 in block %d", resets.Event.NewCount, resets.Event.Raw.BlockNumber, reset.BlockNumber)
+	}
+
+	// 区间过滤：只看第一次加一所在的区块
+	end := inc.BlockNumber.Uint64()
+	ranged, err := f.counter.FilterIncremented(&bind.FilterOpts{Start: end, End: &end})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ranged.Close()
+	n := 0
+	for ranged.Next() {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("Incremented in block %d: %d events; want 1", end, n)
+	}
+}
+
+func TestParseEvents(t *testing.T) {
+	f := newFixture(t)
+	receipts := []*types.Receipt{
+		f.transact(t, f.counter.Increment),
+		f.transact(t, f.counter.Decrement),
+		f.transact(t, f.counter.Reset),
+	}
+	for i, r := range receipts {
+		if len(r.Logs) != 1 {
+			t.Fatalf("receipt %d has %d logs; want 1", i, len(r.Logs))
+		}
+	}
+	if ev, err := f.counter.ParseIncremented(*receipts[0].Logs[0]); err != nil || ev.NewCount.Int64() != 1 {
+		t.Errorf("ParseIncremented = %v, %v", ev, err)
+	}
+	if ev, err := f.counter.ParseDecremented(*receipts[1].Logs[0]); err != nil || ev.NewCount.Int64() != 0 {
+		t.Errorf("ParseDecremented = %v, %v", ev, err)
+	}
+	if ev, err := f.counter.ParseReset(*receipts[2].Logs[0]); err != nil || ev.NewCount.Int64() != 0 {
+		t.Errorf("ParseReset = %v, %v", ev, err)
+	}
+	// 事件签名不匹配时应报错
+	if _, err := f.counter.ParseReset(*receipts[0].Logs[0]); err == nil {
+		t.Error("ParseReset accepted an Incremented log")
+	}
+}
+
+// receive 从订阅通道读取一个事件，超时则失败。
+func receive[T any](t *testing.T, ch <-chan T, errc <-chan error) T {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case err := <-errc:
+		t.Fatalf("subscription failed: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	var zero T
+	return zero
+}
+
+func TestWatchEvents(t *testing.T) {
+	f := newFixture(t)
+	opts := &bind.WatchOpts{Context: context.Background()}
+
+	incs := make(chan *CounterIncremented, 4)
+	incSub, err := f.counter.WatchIncremented(opts, incs)
+	if err != nil {
+		t.Fatalf("WatchIncremented: %v", err)
+	}
+	defer incSub.Unsubscribe()
+	decs := make(chan *CounterDecremented, 4)
+	decSub, err := f.counter.WatchDecremented(opts, decs)
+	if err != nil {
+		t.Fatalf("WatchDecremented: %v", err)
+	}
+	defer decSub.Unsubscribe()
+	resets := make(chan *CounterReset, 4)
+	resetSub, err := f.counter.WatchReset(opts, resets)
+	if err != nil {
+		t.Fatalf("WatchReset: %v", err)
+	}
+	defer resetSub.Unsubscribe()
+	owners := make(chan *CounterOwnershipTransferred, 4)
+	ownerSub, err := f.counter.WatchOwnershipTransferred(opts, owners, []common.Address{f.auth.From}, nil)
+	if err != nil {
+		t.Fatalf("WatchOwnershipTransferred: %v", err)
+	}
+	defer ownerSub.Unsubscribe()
+
+	inc := f.transact(t, f.counter.Increment)
+	if ev := receive(t, incs, incSub.Err()); ev.NewCount.Int64() != 1 || ev.Raw.TxHash != inc.TxHash {
+		t.Errorf("watched Incremented = %d in %s", ev.NewCount, ev.Raw.TxHash.Hex())
+	}
+	dec := f.transact(t, f.counter.Decrement)
+	if ev := receive(t, decs, decSub.Err()); ev.NewCount.Int64() != 0 || ev.Raw.TxHash != dec.TxHash {
+		t.Errorf("watched Decremented = %d in %s", ev.NewCount, ev.Raw.TxHash.Hex())
+	}
+	reset := f.transact(t, f.counter.Reset)
+	if ev := receive(t, resets, resetSub.Err()); ev.NewCount.Sign() != 0 || ev.Raw.TxHash != reset.TxHash {
+		t.Errorf("watched Reset = %d in %s", ev.NewCount, ev.Raw.TxHash.Hex())
+	}
+	transfer := f.transact(t, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return f.counter.TransferOwnership(auth, f.other.From)
+	})
+	if ev := receive(t, owners, ownerSub.Err()); ev.NewOwner != f.other.From || ev.Raw.TxHash != transfer.TxHash {
+		t.Errorf("watched OwnershipTransferred to %s in %s", ev.NewOwner.Hex(), ev.Raw.TxHash.Hex())
+	}
+}
+
+func TestResetOnlyOwner(t *testing.T) {
+	f := newFixture(t)
+	f.transactAs(t, f.other, f.counter.Increment)
+
+	_, err := f.counter.Reset(f.other)
+	args := expectRevert(t, err, "OwnableUnauthorizedAccount")
+	if len(args) != 1 || args[0] != f.other.From {
+		t.Errorf("OwnableUnauthorizedAccount args = %v; want [%s]", args, f.other.From.Hex())
+	}
+	if got := f.count(t); got != 1 {
+		t.Errorf("count after unauthorized reset = %d; want 1", got)
+	}
+
+	f.transact(t, f.counter.Reset)
+	if got := f.count(t); got != 0 {
+		t.Errorf("count after owner reset = %d; want 0", got)
+	}
+}
+
+func TestTransferOwnership(t *testing.T) {
+	f := newFixture(t)
+
+	// 非所有者不能转移所有权
+	_, err := f.counter.TransferOwnership(f.other, f.other.From)
+	expectRevert(t, err, "OwnableUnauthorizedAccount")
+	// 不能转给零地址
+	_, err = f.counter.TransferOwnership(f.auth, common.Address{})
+	expectRevert(t, err, "OwnableInvalidOwner")
+
+	receipt := f.transact(t, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return f.counter.TransferOwnership(auth, f.other.From)
+	})
+	owner, err := f.counter.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != f.other.From {
+		t.Fatalf("owner = %s; want %s", owner.Hex(), f.other.From.Hex())
+	}
+
+	// 原所有者失去权限，新所有者可以重置
+	_, err = f.counter.Reset(f.auth)
+	expectRevert(t, err, "OwnableUnauthorizedAccount")
+	f.transactAs(t, f.other, f.counter.Reset)
+
+	// 部署和转移各产生一条 OwnershipTransferred，可以按 indexed 参数过滤
+	it, err := f.counter.FilterOwnershipTransferred(&bind.FilterOpts{}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var events []*CounterOwnershipTransferred
+	for it.Next() {
+		events = append(events, it.Event)
+	}
+	if len(events) != 2 {
+		t.Fatalf("%d OwnershipTransferred events; want 2", len(events))
+	}
+	if events[0].PreviousOwner != (common.Address{}) || events[0].NewOwner != f.auth.From {
+		t.Errorf("deploy event = %s -> %s", events[0].PreviousOwner.Hex(), events[0].NewOwner.Hex())
+	}
+	if events[1].PreviousOwner != f.auth.From || events[1].NewOwner != f.other.From || events[1].Raw.TxHash != receipt.TxHash {
+		t.Errorf("transfer event = %s -> %s in %s", events[1].PreviousOwner.Hex(), events[1].NewOwner.Hex(), events[1].Raw.TxHash.Hex())
+	}
+
+	filtered, err := f.counter.FilterOwnershipTransferred(&bind.FilterOpts{}, nil, []common.Address{f.other.From})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer filtered.Close()
+	n := 0
+	for filtered.Next() {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("%d events with newOwner %s; want 1", n, f.other.From.Hex())
+	}
+}
+
+func TestRenounceOwnership(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.counter.RenounceOwnership(f.other)
+	expectRevert(t, err, "OwnableUnauthorizedAccount")
+
+	receipt := f.transact(t, f.counter.RenounceOwnership)
+	ev, err := f.counter.ParseOwnershipTransferred(*receipt.Logs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.PreviousOwner != f.auth.From || ev.NewOwner != (common.Address{}) {
+		t.Errorf("renounce event = %s -> %s", ev.PreviousOwner.Hex(), ev.NewOwner.Hex())
+	}
+	owner, err := f.counter.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != (common.Address{}) {
+		t.Errorf("owner after renounce = %s; want zero address", owner.Hex())
+	}
+
+	// 放弃后任何人都不能重置，但加减仍然可用
+	_, err = f.counter.Reset(f.auth)
+	expectRevert(t, err, "OwnableUnauthorizedAccount")
+	f.transactAs(t, f.other, f.counter.Increment)
+	f.transactAs(t, f.other, f.counter.Decrement)
+}
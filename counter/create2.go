@@ -0,0 +1,50 @@
+package counter
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/clc781032855/go_ethereum/create2"
+)
+
+// InitCode 返回以 initialOwner 为所有者部署 Counter 的创建字节码（字节码加构造参数）。
+func InitCode(initialOwner common.Address) ([]byte, error) {
+	parsed, err := CounterMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	args, err := parsed.Pack("", initialOwner)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.FromHex(CounterBin), args...), nil
+}
+
+// PredictCounterAddress 返回 DeployCounterCreate2 会部署到的地址，不需要连接网络。
+// 所有者是创建字节码的一部分，同一个 salt 对不同所有者得到不同地址，别人无法抢先占用。
+func PredictCounterAddress(salt common.Hash, initialOwner common.Address) (common.Address, error) {
+	initCode, err := InitCode(initialOwner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return create2.Address(salt, initCode), nil
+}
+
+// DeployCounterCreate2 通过确定性部署代理用 CREATE2 部署 Counter，地址由 salt 和 initialOwner 决定。
+func DeployCounterCreate2(auth *bind.TransactOpts, backend bind.ContractBackend, salt common.Hash, initialOwner common.Address) (common.Address, *types.Transaction, *Counter, error) {
+	initCode, err := InitCode(initialOwner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	tx, err := create2.Transact(auth, backend, salt, initCode)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address := create2.Address(salt, initCode)
+	instance, err := NewCounter(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
@@ -0,0 +1,4 @@
+package counter
+
+// counter.go 和 runtime.go 由 contracts/Counter.sol 生成，修改合约后运行 go generate ./counter
+//go:generate go run ../cmd/contractgen -src ../contracts/Counter.sol -contract Counter -build ../build -pkg counter -out counter.go -runtime runtime.go
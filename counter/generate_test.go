@@ -0,0 +1,21 @@
+package counter
+
+import (
+	"testing"
+
+	"github.com/clc781032855/go_ethereum/internal/gentest"
+)
+
+// 保证 counter.go、build/ 和 contracts/Counter.sol 三者一致。
+// 失败时运行 go generate ./counter 重新生成。
+func TestGenerated(t *testing.T) {
+	gentest.Check(t, gentest.Contract{
+		Name:     "Counter",
+		Source:   "../contracts/Counter.sol",
+		BuildDir: "../build",
+		Binding:  "counter.go",
+		Pkg:      "counter",
+		Meta:     CounterMetaData,
+		Runtime:  CounterRuntime,
+	})
+}
@@ -0,0 +1,68 @@
+// Package create2 通过确定性部署代理用 CREATE2 部署合约。
+//
+// 代理（https://github.com/Arachnid/deterministic-deployment-proxy）在主网和大多数测试网上
+// 位于同一地址。调用数据是 32 字节 salt 加合约创建字节码，合约地址只由代理地址、salt 和
+// 创建字节码（包括构造参数）决定，与部署者和 nonce 无关，因此可以离线预测，并且在所有网络上相同。
+package create2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ProxyAddress 是确定性部署代理的地址
+var ProxyAddress = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// ProxyCode 是代理的运行时代码，本地开发链和测试把它写进创世区块
+var ProxyCode = common.FromHex("0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3")
+
+// ErrNoProxy 表示当前网络上没有部署确定性部署代理
+var ErrNoProxy = errors.New("deterministic deployment proxy is not deployed on this network")
+
+// ParseSalt 解析 salt：空字符串为全零，0x 开头的 32 字节十六进制按原值使用，
+// 其它字符串（例如 "counter-v1"）取 keccak256，方便用可读的名称区分多个实例。
+func ParseSalt(s string) (common.Hash, error) {
+	if s == "" {
+		return common.Hash{}, nil
+	}
+	if strings.HasPrefix(s, "0x") {
+		b, err := hexutil.Decode(s)
+		if err != nil || len(b) != common.HashLength {
+			return common.Hash{}, fmt.Errorf("salt %q is not 32 bytes of hex", s)
+		}
+		return common.BytesToHash(b), nil
+	}
+	return crypto.Keccak256Hash([]byte(s)), nil
+}
+
+// Address 返回用 salt 和创建字节码 initCode 部署的合约地址。
+func Address(salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(ProxyAddress, salt, crypto.Keccak256(initCode))
+}
+
+// CheckProxy 确认代理已经部署，没有时返回 ErrNoProxy。
+func CheckProxy(ctx context.Context, caller bind.ContractCaller) error {
+	code, err := caller.CodeAt(ctx, ProxyAddress, nil)
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return ErrNoProxy
+	}
+	return nil
+}
+
+// Transact 发送调用代理的部署交易。地址上已有合约时 CREATE2 失败，代理会回滚。
+func Transact(auth *bind.TransactOpts, backend bind.ContractBackend, salt common.Hash, initCode []byte) (*types.Transaction, error) {
+	proxy := bind.NewBoundContract(ProxyAddress, abi.ABI{}, backend, backend, backend)
+	return proxy.RawTransact(auth, append(salt.Bytes(), initCode...))
+}
@@ -0,0 +1,34 @@
+package create2
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestParseSalt(t *testing.T) {
+	hex := "0x00000000000000000000000000000000000000000000000000000000000000ff"
+	tests := []struct {
+		in   string
+		want common.Hash
+		ok   bool
+	}{
+		{"", common.Hash{}, true},
+		{hex, common.HexToHash(hex), true},
+		{"counter-v1", crypto.Keccak256Hash([]byte("counter-v1")), true},
+		{"0xff", common.Hash{}, false},
+		{"0x" + hex[3:], common.Hash{}, false},
+		{hex[:65] + "zz", common.Hash{}, false},
+	}
+	for _, tt := range tests {
+		got, err := ParseSalt(tt.in)
+		if (err == nil) != tt.ok {
+			t.Errorf("ParseSalt(%q) error = %v; want ok %v", tt.in, err, tt.ok)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSalt(%q) = %s; want %s", tt.in, got.Hex(), tt.want.Hex())
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package deployments 按链记录已部署的合约，命令可以用名称代替地址。
+//
+// 每条链一个 JSON 文件 <dir>/<chainId>.json，同一名称重新部署时覆盖旧记录。
+// 默认目录 DefaultDir 只保存本地记录，不提交到版本库；要与他人共享测试网或主网的部署，
+// 用 --deployments 或 ETH_DEPLOYMENTS 指定一个单独提交的目录。
+package deployments
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EnvDir 是部署记录目录的环境变量
+const EnvDir = "ETH_DEPLOYMENTS"
+
+// DefaultDir 是未指定目录时使用的目录（相对于当前目录）。它是隐藏目录，
+// 不会与源码中的 deployments 包混在一起；其中多是本地开发链的记录，已在 .gitignore 中忽略
+const DefaultDir = ".deployments"
+
+// ErrNotFound 表示当前链上没有该名称的部署记录
+var ErrNotFound = errors.New("deployment not found")
+
+// validName 限制名称只包含字母、数字、点、下划线和连字符，并以字母开头，避免与地址混淆
+var validName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9._-]*$`)
+
+// Deployment 是一次部署的记录。复用已有合约（CREATE2 --if-absent）时交易相关字段为 null
+type Deployment struct {
+	Contract       string          `json:"contract"` // 合约名，例如 Counter
+	Address        common.Address  `json:"address"`
+	TxHash         *common.Hash    `json:"txHash"`
+	BlockNumber    *uint64         `json:"blockNumber"`
+	Deployer       *common.Address `json:"deployer"`
+	BytecodeHash   common.Hash     `json:"bytecodeHash"`             // 链上运行时代码的 keccak256
+	ABIVersion     string          `json:"abiVersion"`               // 见 ABIVersion
+	Salt           *common.Hash    `json:"salt,omitempty"`           // CREATE2 部署的 salt
+	Implementation *common.Address `json:"implementation,omitempty"` // 代理当前指向的实现合约
+}
+
+// file 是一条链的记录文件
+type file struct {
+	ChainID     uint64                 `json:"chainId"`
+	Deployments map[string]*Deployment `json:"deployments"`
+}
+
+// ABIVersion 返回 ABI JSON 的版本标识（keccak256 的前 8 字节），ABI 改变时随之改变。
+func ABIVersion(abiJSON string) string {
+	return common.Bytes2Hex(crypto.Keccak256([]byte(abiJSON))[:8])
+}
+
+// ValidName 判断 name 能否用作部署名称。
+func ValidName(name string) bool {
+	return validName.MatchString(name)
+}
+
+// Store 是保存在目录 Dir 中的部署记录
+type Store struct {
+	Dir string
+}
+
+// Open 返回目录 dir 中的部署记录，dir 为空时使用 ETH_DEPLOYMENTS，仍为空则使用 DefaultDir。
+// 目录在第一次写入时创建。
+func Open(dir string) *Store {
+	if dir == "" {
+		dir = os.Getenv(EnvDir)
+	}
+	if dir == "" {
+		dir = DefaultDir
+	}
+	return &Store{Dir: dir}
+}
+
+// Path 返回链 chainID 的记录文件路径。
+func (s *Store) Path(chainID uint64) string {
+	return filepath.Join(s.Dir, strconv.FormatUint(chainID, 10)+".json")
+}
+
+func (s *Store) load(chainID uint64) (*file, error) {
+	f := &file{ChainID: chainID, Deployments: map[string]*Deployment{}}
+	data, err := os.ReadFile(s.Path(chainID))
+	if errors.Is(err, os.ErrNotExist) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", s.Path(chainID), err)
+	}
+	if f.ChainID != chainID {
+		return nil, fmt.Errorf("%s records chain %d, want %d", s.Path(chainID), f.ChainID, chainID)
+	}
+	if f.Deployments == nil {
+		f.Deployments = map[string]*Deployment{}
+	}
+	return f, nil
+}
+
+// Get 返回链 chainID 上名为 name 的部署，没有时返回 ErrNotFound。
+func (s *Store) Get(chainID uint64, name string) (*Deployment, error) {
+	f, err := s.load(chainID)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := f.Deployments[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q on chain %d (%s)", ErrNotFound, name, chainID, s.Path(chainID))
+	}
+	return d, nil
+}
+
+// Names 返回链 chainID 上所有部署名称，按字母排序。
+func (s *Store) Names(chainID uint64) ([]string, error) {
+	f, err := s.load(chainID)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(f.Deployments))
+	for name := range f.Deployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Save 以 name 记录 d，覆盖同名的旧记录。文件先写到临时文件再改名，中断时不会留下半个文件。
+func (s *Store) Save(chainID uint64, name string, d *Deployment) error {
+	if !ValidName(name) {
+		return fmt.Errorf("invalid deployment name %q", name)
+	}
+	f, err := s.load(chainID)
+	if err != nil {
+		return err
+	}
+	f.Deployments[name] = d
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, "deployments-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.Path(chainID))
+}
@@ -0,0 +1,81 @@
+package deployments
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestSaveGet(t *testing.T) {
+	store := Open(filepath.Join(t.TempDir(), "deployments"))
+	hash := common.HexToHash("0x01")
+	block := uint64(7)
+	deployer := common.HexToAddress("0x02")
+	d := &Deployment{
+		Contract:     "Counter",
+		Address:      common.HexToAddress("0x03"),
+		TxHash:       &hash,
+		BlockNumber:  &block,
+		Deployer:     &deployer,
+		BytecodeHash: common.HexToHash("0x04"),
+		ABIVersion:   ABIVersion("[]"),
+	}
+
+	if _, err := store.Get(1, "Counter"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get before Save: err = %v; want ErrNotFound", err)
+	}
+	if err := store.Save(1, "Counter", d); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(1, "Counter-v2", &Deployment{Contract: "Counter"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Get(1, "Counter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, d) {
+		t.Errorf("Get = %+v; want %+v", got, d)
+	}
+	names, err := store.Names(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Counter", "Counter-v2"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Names = %v; want %v", names, want)
+	}
+
+	// 每条链单独一个文件
+	if _, err := store.Get(5, "Counter"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get on another chain: err = %v; want ErrNotFound", err)
+	}
+	if _, err := os.Stat(store.Path(5)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("reading chain 5 created %s", store.Path(5))
+	}
+}
+
+func TestSaveRejectsInvalidName(t *testing.T) {
+	store := Open(t.TempDir())
+	for _, name := range []string{"", "0xabc", "my counter", "../x"} {
+		if err := store.Save(1, name, &Deployment{}); err == nil {
+			t.Errorf("Save(%q) succeeded", name)
+		}
+	}
+}
+
+func TestLoadRejectsOtherChain(t *testing.T) {
+	store := Open(t.TempDir())
+	if err := store.Save(1, "Counter", &Deployment{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(store.Path(1), store.Path(2)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(2, "Counter"); err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("Get from a file recording another chain: err = %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+// Package devchain 在进程内启动一条本地开发链，并通过 HTTP / WebSocket JSON-RPC 对外提供服务。
+//
+// 开发链由 go-ethereum 的完整节点加模拟信标链驱动，数据只保存在内存中，进程退出即丢失。
+// 预置账户从固定助记词派生，每次启动地址和私钥都相同，方便多个工具共用。
+package devchain
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/catalyst"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/clc781032855/go_ethereum/create2"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+// DefaultMnemonic 是预置账户使用的助记词，与 Hardhat / Anvil 相同。只能用于本地开发。
+const DefaultMnemonic = "test test test test test test test test test test test junk"
+
+// 默认值
+const (
+	DefaultChainID  = 1337
+	DefaultAccounts = 10
+	DefaultGasLimit = 30_000_000
+)
+
+// DefaultBalance 是每个预置账户的初始余额：10000 ETH
+var DefaultBalance = new(big.Int).Mul(big.NewInt(10_000), big.NewInt(params.Ether))
+
+// accountPath 是第 i 个预置账户的派生路径
+const accountPath = "m/44'/60'/0'/0/%d"
+
+// 对外开放的 RPC 命名空间，dev 提供 dev_addWithdrawal / dev_setFeeRecipient
+var modules = []string{"eth", "net", "web3", "txpool", "dev"}
+
+// Config 描述开发链的参数，零值字段使用默认值。
+type Config struct {
+	ChainID   uint64
+	HTTPAddr  string        // HTTP JSON-RPC 监听地址 host:port，端口为 0 时随机分配
+	WSAddr    string        // WebSocket 监听地址，为空时不开启；与 HTTPAddr 相同时共用端口
+	BlockTime time.Duration // 出块间隔，必须是整秒；0 表示收到交易立即出块
+	Accounts  int           // 预置账户数
+	Balance   *big.Int      // 每个预置账户的余额 (Wei)
+	Mnemonic  string        // 派生预置账户的助记词
+	GasLimit  uint64        // 区块 gas limit
+}
+
+func (c *Config) setDefaults() {
+	if c.ChainID == 0 {
+		c.ChainID = DefaultChainID
+	}
+	if c.HTTPAddr == "" {
+		c.HTTPAddr = "127.0.0.1:8545"
+	}
+	if c.Accounts <= 0 {
+		c.Accounts = DefaultAccounts
+	}
+	if c.Balance == nil {
+		c.Balance = DefaultBalance
+	}
+	if c.Mnemonic == "" {
+		c.Mnemonic = DefaultMnemonic
+	}
+	if c.GasLimit == 0 {
+		c.GasLimit = DefaultGasLimit
+	}
+}
+
+// Account 是一个预置账户
+type Account struct {
+	Address common.Address
+	Key     *ecdsa.PrivateKey
+	Path    string
+}
+
+// Chain 是运行中的开发链。
+type Chain struct {
+	stack    *node.Node
+	config   Config
+	accounts []Account
+}
+
+// Start 创建创世区块并启动节点，返回时 RPC 端点已经可以访问。
+func Start(cfg Config) (*Chain, error) {
+	cfg.setDefaults()
+	if cfg.BlockTime < 0 || cfg.BlockTime%time.Second != 0 {
+		return nil, fmt.Errorf("block time %s must be a whole number of seconds", cfg.BlockTime)
+	}
+
+	accounts := make([]Account, cfg.Accounts)
+	for i := range accounts {
+		path := fmt.Sprintf(accountPath, i)
+		key, err := signer.DeriveKey(cfg.Mnemonic, "", path)
+		if err != nil {
+			return nil, fmt.Errorf("derive account %d: %w", i, err)
+		}
+		accounts[i] = Account{Address: crypto.PubkeyToAddress(key.PublicKey), Key: key, Path: path}
+	}
+
+	nodeConf, err := nodeConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	stack, err := node.New(nodeConf)
+	if err != nil {
+		return nil, fmt.Errorf("create node: %w", err)
+	}
+
+	ethConf := ethconfig.Defaults
+	ethConf.NetworkId = cfg.ChainID
+	ethConf.Genesis = genesis(cfg, accounts)
+	ethConf.SyncMode = downloader.FullSync
+	ethConf.Miner.GasCeil = cfg.GasLimit
+	ethConf.TxPool.NoLocals = true
+	backend, err := eth.New(stack, &ethConf)
+	if err != nil {
+		stack.Close()
+		return nil, fmt.Errorf("create eth service: %w", err)
+	}
+	filterSystem := filters.NewFilterSystem(backend.APIBackend, filters.Config{})
+	stack.RegisterAPIs([]rpc.API{{Namespace: "eth", Service: filters.NewFilterAPI(filterSystem)}})
+
+	// 模拟信标链负责出块：间隔为 0 时由 dev API 在收到交易后立即出块
+	beacon, err := catalyst.NewSimulatedBeacon(uint64(cfg.BlockTime/time.Second), backend)
+	if err != nil {
+		stack.Close()
+		return nil, fmt.Errorf("create simulated beacon: %w", err)
+	}
+	catalyst.RegisterSimulatedBeaconAPIs(stack, beacon)
+	stack.RegisterLifecycle(beacon)
+
+	if err := stack.Start(); err != nil {
+		stack.Close()
+		return nil, fmt.Errorf("start node: %w", err)
+	}
+	return &Chain{stack: stack, config: cfg, accounts: accounts}, nil
+}
+
+// nodeConfig 创建只监听本地 RPC、不连接任何对等节点的节点配置。
+func nodeConfig(cfg Config) (*node.Config, error) {
+	conf := node.DefaultConfig
+	conf.Name = "ethtool-dev"
+	conf.DataDir = "" // 只保存在内存中
+	conf.P2P = p2p.Config{NoDiscovery: true, MaxPeers: 0, ListenAddr: ""}
+
+	host, port, err := splitHostPort(cfg.HTTPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("http address: %w", err)
+	}
+	conf.HTTPHost, conf.HTTPPort = host, port
+	conf.HTTPModules = modules
+	conf.HTTPCors = []string{"*"}
+	conf.HTTPVirtualHosts = []string{"*"}
+
+	if cfg.WSAddr != "" {
+		host, port, err := splitHostPort(cfg.WSAddr)
+		if err != nil {
+			return nil, fmt.Errorf("websocket address: %w", err)
+		}
+		conf.WSHost, conf.WSPort = host, port
+		conf.WSModules = modules
+		conf.WSOrigins = []string{"*"}
+	}
+	return &conf, nil
+}
+
+func splitHostPort(addr string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", 0, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port %q", portStr)
+	}
+	return host, port, nil
+}
+
+// ListenAddr 从 RPC 地址（例如 http://127.0.0.1:8545）取出 host:port，未写端口时使用 8545。
+func ListenAddr(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Hostname() == "" {
+		return "", errors.New("missing host")
+	}
+	port := u.Port()
+	if port == "" {
+		port = "8545"
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// genesis 创建开发链的创世区块：启用到 Prague 的全部升级，难度为 0（直接处于合并后状态）。
+func genesis(cfg Config, accounts []Account) *core.Genesis {
+	chainConfig := *params.AllDevChainProtocolChanges
+	chainConfig.ChainID = new(big.Int).SetUint64(cfg.ChainID)
+
+	g := core.DeveloperGenesisBlock(cfg.GasLimit, nil)
+	g.Config = &chainConfig
+	for _, acc := range accounts {
+		g.Alloc[acc.Address] = types.Account{Balance: new(big.Int).Set(cfg.Balance)}
+	}
+	// 与公共网络一样提供确定性部署代理，CREATE2 部署的地址在开发链上也相同
+	g.Alloc[create2.ProxyAddress] = types.Account{Code: create2.ProxyCode, Balance: new(big.Int)}
+	return g
+}
+
+// Accounts 返回预置账户。
+func (c *Chain) Accounts() []Account { return c.accounts }
+
+// ChainID 返回链ID。
+func (c *Chain) ChainID() uint64 { return c.config.ChainID }
+
+// BlockTime 返回出块间隔，0 表示收到交易立即出块。
+func (c *Chain) BlockTime() time.Duration { return c.config.BlockTime }
+
+// HTTPEndpoint 返回 HTTP JSON-RPC 地址。
+func (c *Chain) HTTPEndpoint() string { return c.stack.HTTPEndpoint() }
+
+// WSEndpoint 返回 WebSocket JSON-RPC 地址，未开启时返回空字符串。
+func (c *Chain) WSEndpoint() string {
+	if c.config.WSAddr == "" {
+		return ""
+	}
+	return c.stack.WSEndpoint()
+}
+
+// Attach 返回进程内的 RPC 客户端，不经过网络。
+func (c *Chain) Attach() *rpc.Client { return c.stack.Attach() }
+
+// Close 停止节点并释放端口，链上数据随之丢失。
+func (c *Chain) Close() error { return c.stack.Close() }
@@ -0,0 +1,176 @@
+package devchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+func startChain(t *testing.T, cfg Config) *Chain {
+	t.Helper()
+	cfg.HTTPAddr = "127.0.0.1:0"
+	cfg.WSAddr = "127.0.0.1:0"
+	c, err := Start(cfg)
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestAccounts(t *testing.T) {
+	c := startChain(t, Config{Accounts: 3})
+	accounts := c.Accounts()
+	if len(accounts) != 3 {
+		t.Fatalf("%d accounts; want 3", len(accounts))
+	}
+	// 与 Hardhat / Anvil 的第一个账户相同
+	if want := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"); accounts[0].Address != want {
+		t.Errorf("account 0 = %s; want %s", accounts[0].Address.Hex(), want.Hex())
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, c.HTTPEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chainID.Uint64() != DefaultChainID {
+		t.Errorf("chain id = %s; want %d", chainID, DefaultChainID)
+	}
+	for _, acc := range accounts {
+		balance, err := client.BalanceAt(ctx, acc.Address, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.Cmp(DefaultBalance) != 0 {
+			t.Errorf("balance of %s = %s; want %s", acc.Address.Hex(), balance, DefaultBalance)
+		}
+	}
+}
+
+// 出块间隔为 0 时，交易进入交易池后立即出块
+func TestInstantMining(t *testing.T) {
+	c := startChain(t, Config{ChainID: 31337})
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, c.WSEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	heads := make(chan *types.Header, 8)
+	sub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		t.Fatalf("subscribe new heads: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	s := signer.NewKeySigner(c.Accounts()[0].Key)
+	chainID := new(big.Int).SetUint64(c.ChainID())
+	fees, err := gas.SuggestFees(ctx, client, gas.FeeConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := c.Accounts()[1].Address
+	tx, err := s.SignTx(ctx, fees.NewTx(chainID, 0, &to, big.NewInt(params.Ether), params.TxGas, nil), chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case head := <-heads:
+		if head.Number.Uint64() != 1 {
+			t.Errorf("first head = %d; want 1", head.Number)
+		}
+	case err := <-sub.Err():
+		t.Fatal(err)
+	case <-ctx.Done():
+		t.Fatal("no block produced after sending a transaction")
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Error("transfer failed")
+	}
+
+	// 部署合约同样会立即打包
+	auth := signer.TransactOpts(ctx, s, chainID)
+	auth.Context = ctx
+	address, deployTx, _, err := counter.DeployCounter(auth, client, auth.From)
+	if err != nil {
+		t.Fatalf("DeployCounter: %v", err)
+	}
+	if got, err := bind.WaitDeployed(ctx, client, deployTx); err != nil || got != address {
+		t.Fatalf("WaitDeployed = %s, %v; want %s", got.Hex(), err, address.Hex())
+	}
+}
+
+func TestIntervalMining(t *testing.T) {
+	c := startChain(t, Config{BlockTime: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, c.HTTPEndpoint())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	// 没有交易也按间隔出块
+	for {
+		n, err := client.BlockNumber(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n >= 2 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("head is still %d", n)
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+}
+
+func TestStartRejectsFractionalBlockTime(t *testing.T) {
+	if _, err := Start(Config{HTTPAddr: "127.0.0.1:0", BlockTime: 1500 * time.Millisecond}); err == nil {
+		t.Fatal("Start accepted a 1.5s block time")
+	}
+}
+
+func TestListenAddr(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"http://127.0.0.1:8545", "127.0.0.1:8545"},
+		{"ws://localhost:8546", "localhost:8546"},
+		{"http://localhost", "localhost:8545"},
+	}
+	for _, tt := range tests {
+		got, err := ListenAddr(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ListenAddr(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := ListenAddr("not a url"); err == nil {
+		t.Error("ListenAddr accepted a URL without host")
+	}
+}
@@ -0,0 +1,210 @@
+// Package errs 定义命令行使用的错误分类。
+//
+// 每个 *Error 都带有一个 Kind，errors.Is 可以同时匹配分类哨兵（例如 ErrNonce）
+// 和底层错误，errors.As 可以取出 *Error 本身。节点通过 JSON-RPC 返回的错误只有文本，
+// 因此 Classify 在哨兵之外还会匹配 geth 的标准错误消息。
+package errs
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/revert"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+// Kind 是错误分类
+type Kind int
+
+const (
+	Unknown           Kind = iota
+	Usage                  // 参数或配置错误
+	Connection             // 无法连接节点或签名器
+	ChainMismatch          // 节点的链ID或创世区块与配置不符
+	Signing                // 打开签名器或签名失败
+	InsufficientFunds      // 余额不足以支付金额和手续费
+	Nonce                  // nonce 过低、过高或已被使用
+	Underpriced            // 手续费过低，包括替换交易加价不足
+	Reverted               // 交易或调用执行回滚
+	Timeout                // 超时
+	CodeMismatch           // 合约地址上没有代码或代码与编译产物不符
+)
+
+// 分类哨兵，对 *Error 而言 errors.Is(err, ErrNonce) 等价于 Kind == Nonce
+var (
+	ErrUsage             = errors.New("usage error")
+	ErrConnection        = errors.New("connection error")
+	ErrChainMismatch     = chain.ErrChainMismatch
+	ErrSigning           = errors.New("signing error")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrNonce             = errors.New("nonce error")
+	ErrUnderpriced       = errors.New("transaction underpriced")
+	ErrReverted          = errors.New("execution reverted")
+	ErrTimeout           = errors.New("timeout")
+	ErrCodeMismatch      = bytecode.ErrMismatch
+)
+
+var sentinels = map[Kind]error{
+	Usage:             ErrUsage,
+	Connection:        ErrConnection,
+	ChainMismatch:     ErrChainMismatch,
+	Signing:           ErrSigning,
+	InsufficientFunds: ErrInsufficientFunds,
+	Nonce:             ErrNonce,
+	Underpriced:       ErrUnderpriced,
+	Reverted:          ErrReverted,
+	Timeout:           ErrTimeout,
+	CodeMismatch:      ErrCodeMismatch,
+}
+
+var kindNames = map[Kind]string{
+	Unknown:           "unknown",
+	Usage:             "usage",
+	Connection:        "connection",
+	ChainMismatch:     "chain_mismatch",
+	Signing:           "signing",
+	InsufficientFunds: "insufficient_funds",
+	Nonce:             "nonce",
+	Underpriced:       "underpriced",
+	Reverted:          "reverted",
+	Timeout:           "timeout",
+	CodeMismatch:      "code_mismatch",
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// 退出码，0 表示成功
+var exitCodes = map[Kind]int{
+	Unknown:           1,
+	Usage:             2,
+	Connection:        3,
+	ChainMismatch:     4,
+	Signing:           5,
+	InsufficientFunds: 6,
+	Nonce:             7,
+	Underpriced:       8,
+	Reverted:          9,
+	Timeout:           10,
+	CodeMismatch:      11,
+}
+
+// ExitCode 返回 err 对应的进程退出码。
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return exitCodes[Classify(err)]
+}
+
+// Error 是带分类的错误，Msg 是面向用户的描述（通常已包含底层错误的文本）
+type Error struct {
+	Kind Kind
+	Msg  string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Msg != "" {
+		return e.Msg
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return e.Kind.String()
+}
+
+// Unwrap 同时返回分类哨兵和底层错误。
+func (e *Error) Unwrap() []error {
+	var errs []error
+	if sentinel := sentinels[e.Kind]; sentinel != nil {
+		errs = append(errs, sentinel)
+	}
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	return errs
+}
+
+// New 创建不包装其他错误的分类错误。
+func New(kind Kind, msg string) *Error {
+	return &Error{Kind: kind, Msg: msg}
+}
+
+// Wrap 包装 err：能从 err 识别出分类时使用识别结果，否则使用 fallback。
+// err 为 nil 时返回 nil。
+func Wrap(err error, fallback Kind, msg string) error {
+	if err == nil {
+		return nil
+	}
+	kind := Classify(err)
+	if kind == Unknown {
+		kind = fallback
+	}
+	return &Error{Kind: kind, Msg: msg, Err: err}
+}
+
+// Classify 识别错误分类：优先使用错误链上的 *Error 和已知哨兵，再匹配节点返回的错误消息。
+func Classify(err error) Kind {
+	if err == nil {
+		return Unknown
+	}
+	var e *Error
+	if errors.As(err, &e) && e.Kind != Unknown {
+		return e.Kind
+	}
+	switch {
+	case errors.Is(err, chain.ErrChainMismatch):
+		return ChainMismatch
+	case errors.Is(err, txtrack.ErrReverted), errors.Is(err, revert.ErrExecutionReverted):
+		return Reverted
+	case errors.Is(err, bytecode.ErrMismatch), errors.Is(err, bytecode.ErrNoCode):
+		return CodeMismatch
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "insufficient funds"):
+		return InsufficientFunds
+	// 替换交易加价不足也是 underpriced，必须在 nonce 之前判断
+	case strings.Contains(msg, "underpriced"),
+		strings.Contains(msg, "fee too low"),
+		strings.Contains(msg, "max fee per gas less than block base fee"):
+		return Underpriced
+	case strings.Contains(msg, "nonce too low"),
+		strings.Contains(msg, "nonce too high"),
+		strings.Contains(msg, "invalid nonce"):
+		return Nonce
+	case strings.Contains(msg, "execution reverted"):
+		return Reverted
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded):
+		return Timeout
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.ECONNRESET):
+		return Connection
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return Timeout
+		}
+		return Connection
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return Connection
+	}
+	return Unknown
+}
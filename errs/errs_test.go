@@ -0,0 +1,147 @@
+package errs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/errs"
+	"github.com/clc781032855/go_ethereum/internal/fakerpc"
+	"github.com/clc781032855/go_ethereum/revert"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+// 退出码是对脚本的约定，不能随意改变
+func TestExitCode(t *testing.T) {
+	for _, tc := range []struct {
+		kind errs.Kind
+		name string
+		code int
+	}{
+		{errs.Unknown, "unknown", 1},
+		{errs.Usage, "usage", 2},
+		{errs.Connection, "connection", 3},
+		{errs.ChainMismatch, "chain_mismatch", 4},
+		{errs.Signing, "signing", 5},
+		{errs.InsufficientFunds, "insufficient_funds", 6},
+		{errs.Nonce, "nonce", 7},
+		{errs.Underpriced, "underpriced", 8},
+		{errs.Reverted, "reverted", 9},
+		{errs.Timeout, "timeout", 10},
+		{errs.CodeMismatch, "code_mismatch", 11},
+	} {
+		err := errs.New(tc.kind, "failed")
+		if got := errs.ExitCode(err); got != tc.code {
+			t.Errorf("ExitCode(%s) = %d; want %d", tc.kind, got, tc.code)
+		}
+		if got := errs.ExitCode(fmt.Errorf("command: %w", err)); got != tc.code {
+			t.Errorf("ExitCode(wrapped %s) = %d; want %d", tc.kind, got, tc.code)
+		}
+		if tc.kind.String() != tc.name {
+			t.Errorf("Kind(%d).String() = %q; want %q", tc.kind, tc.kind.String(), tc.name)
+		}
+	}
+	if got := errs.ExitCode(nil); got != 0 {
+		t.Errorf("ExitCode(nil) = %d; want 0", got)
+	}
+}
+
+// rpcError 通过假节点取得 ethclient 返回的真实 JSON-RPC 错误
+func rpcError(t *testing.T, code int, message string) error {
+	t.Helper()
+	server := fakerpc.New(t, func(string, json.RawMessage) fakerpc.Response {
+		return fakerpc.Response{Error: &fakerpc.Error{Code: code, Message: message}}
+	})
+	_, err := server.Dial(t).BlockNumber(context.Background())
+	if err == nil {
+		t.Fatal("fake node returned no error")
+	}
+	return err
+}
+
+// timeoutError 是超时的 net.Error
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassify(t *testing.T) {
+	reverted := &txtrack.RevertedError{Receipt: &types.Receipt{BlockNumber: big.NewInt(1), Status: types.ReceiptStatusFailed}}
+	for _, tc := range []struct {
+		name string
+		err  error
+		want errs.Kind
+	}{
+		{"nil", nil, errs.Unknown},
+		{"plain", errors.New("boom"), errs.Unknown},
+
+		// 节点返回的 JSON-RPC 错误只有文本
+		{"rpc insufficient funds", fmt.Errorf("send: %w", rpcError(t, -32000, "insufficient funds for gas * price + value")), errs.InsufficientFunds},
+		{"rpc replacement underpriced", fmt.Errorf("send: %w", rpcError(t, -32000, "replacement transaction underpriced")), errs.Underpriced},
+		{"rpc fee too low", fmt.Errorf("send: %w", rpcError(t, -32000, "transaction fee too low")), errs.Underpriced},
+		{"rpc below base fee", fmt.Errorf("send: %w", rpcError(t, -32000, "max fee per gas less than block base fee")), errs.Underpriced},
+		{"rpc nonce too low", fmt.Errorf("send: %w", rpcError(t, -32000, "nonce too low")), errs.Nonce},
+		{"rpc nonce too high", fmt.Errorf("send: %w", rpcError(t, -32000, "Nonce too high")), errs.Nonce},
+		{"rpc execution reverted", fmt.Errorf("estimate: %w", rpcError(t, 3, "execution reverted: Only owner")), errs.Reverted},
+		{"rpc other", fmt.Errorf("call: %w", rpcError(t, -32000, "header not found")), errs.Unknown},
+
+		{"deadline", fmt.Errorf("wait: %w", context.DeadlineExceeded), errs.Timeout},
+		{"os deadline", fmt.Errorf("read: %w", os.ErrDeadlineExceeded), errs.Timeout},
+		{"net timeout", &net.OpError{Op: "dial", Net: "tcp", Err: timeoutError{}}, errs.Timeout},
+		{"retry interrupted", fmt.Errorf("%w: %w", context.DeadlineExceeded, errors.New("http://node: 503 Service Unavailable")), errs.Timeout},
+		{"connection refused", fmt.Errorf("dial: %w", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), errs.Connection},
+		{"dns", &net.DNSError{Err: "no such host", Name: "node.invalid"}, errs.Connection},
+
+		{"receipt reverted", fmt.Errorf("track: %w", reverted), errs.Reverted},
+		{"decoded revert", fmt.Errorf("call: %w", &revert.Error{Kind: revert.KindError, Reason: "Only owner"}), errs.Reverted},
+		{"chain mismatch", fmt.Errorf("connect: %w", chain.ErrChainMismatch), errs.ChainMismatch},
+		{"no code", fmt.Errorf("verify: %w", bytecode.ErrNoCode), errs.CodeMismatch},
+		{"code mismatch", bytecode.ErrMismatch, errs.CodeMismatch},
+
+		// 已分类的错误优先于底层错误的文本
+		{"classified", errs.New(errs.Signing, "nonce too low"), errs.Signing},
+		{"wrapped classified", fmt.Errorf("send: %w", errs.Wrap(errors.New("boom"), errs.Connection, "failed")), errs.Connection},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := errs.Classify(tc.err); got != tc.want {
+				t.Errorf("Classify(%v) = %s; want %s", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	if errs.Wrap(nil, errs.Signing, "failed") != nil {
+		t.Error("Wrap(nil) != nil")
+	}
+
+	// 能识别出分类时不使用 fallback
+	cause := errors.New("nonce too low")
+	err := errs.Wrap(cause, errs.Signing, "send failed")
+	if errs.Classify(err) != errs.Nonce || !errors.Is(err, errs.ErrNonce) || !errors.Is(err, cause) {
+		t.Errorf("Wrap(nonce error) = %v (%s); want Nonce wrapping the cause", err, errs.Classify(err))
+	}
+	if errors.Is(err, errs.ErrSigning) {
+		t.Error("recognized error also matches the fallback sentinel")
+	}
+
+	err = errs.Wrap(errors.New("boom"), errs.Signing, "sign failed")
+	var e *errs.Error
+	if !errors.As(err, &e) || e.Kind != errs.Signing || !errors.Is(err, errs.ErrSigning) {
+		t.Errorf("Wrap(unknown error) = %v; want Signing", err)
+	}
+	if err.Error() != "sign failed" {
+		t.Errorf("message = %q; want %q", err.Error(), "sign failed")
+	}
+}
@@ -0,0 +1,92 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/clc781032855/go_ethereum/revert"
+)
+
+// DefaultMarginPercent 是默认的 gas 安全余量（百分比）。
+const DefaultMarginPercent = 20
+
+// 估算错误
+var (
+	ErrEstimateFailed = errors.New("gas estimation failed")
+	ErrGasCapExceeded = errors.New("estimated gas exceeds cap")
+)
+
+// EstimateConfig 控制 gas limit 的计算方式。
+type EstimateConfig struct {
+	MarginPercent uint64   // 在估算值上增加的百分比，0 时使用 DefaultMarginPercent
+	Cap           uint64   // gas limit 上限，0 时使用最新区块的 gas limit
+	ABI           *abi.ABI // 估算失败时用于解码自定义错误，可以为 nil
+}
+
+// EstimateBackend 是估算 gas 所需的 RPC 接口。
+type EstimateBackend interface {
+	ethereum.GasEstimator
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// EstimateGas 用 eth_estimateGas 估算 gas，加上安全余量后不超过上限。
+// 估算本身失败时（例如调用会回滚），返回包装了 ErrEstimateFailed 的错误，并尽量附上解码后的回滚原因。
+func EstimateGas(ctx context.Context, backend EstimateBackend, msg ethereum.CallMsg, cfg EstimateConfig) (uint64, error) {
+	estimated, err := backend.EstimateGas(ctx, msg)
+	if err != nil {
+		if reason, ok := revert.FromError(err, cfg.ABI); ok {
+			err = reason
+		}
+		return 0, fmt.Errorf("%w: %w", ErrEstimateFailed, err)
+	}
+
+	limit := estimated
+	// 向普通账户的纯转账固定消耗 21000，不需要余量
+	if !(estimated == params.TxGas && len(msg.Data) == 0) {
+		margin := cfg.MarginPercent
+		if margin == 0 {
+			margin = DefaultMarginPercent
+		}
+		limit = estimated + estimated*margin/100
+	}
+
+	limitCap := cfg.Cap
+	if limitCap == 0 {
+		head, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return 0, fmt.Errorf("get latest header: %w", err)
+		}
+		limitCap = head.GasLimit
+	}
+	if estimated > limitCap {
+		return 0, fmt.Errorf("%w: estimated %d, cap %d", ErrGasCapExceeded, estimated, limitCap)
+	}
+	if limit > limitCap {
+		limit = limitCap
+	}
+	return limit, nil
+}
+
+// Estimator 包装合约后端，让 abigen 生成的绑定（GasLimit 为 0 时）也使用 EstimateGas 的余量、上限和错误解码。
+type Estimator struct {
+	bind.ContractBackend
+	Config EstimateConfig
+}
+
+// WithEstimator 返回带 gas 估算策略的合约后端。
+func WithEstimator(backend bind.ContractBackend, cfg EstimateConfig) *Estimator {
+	return &Estimator{ContractBackend: backend, Config: cfg}
+}
+
+// EstimateGas 覆盖 bind.ContractBackend 的同名方法。
+func (e *Estimator) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return EstimateGas(ctx, e.ContractBackend, msg, e.Config)
+}
@@ -0,0 +1,193 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeMode 选择交易类型。
+type FeeMode string
+
+const (
+	FeeModeAuto    FeeMode = "auto"    // 最新区块带 baseFee 时用 EIP-1559，否则退回 legacy
+	FeeModeDynamic FeeMode = "dynamic" // 强制 EIP-1559 (DynamicFeeTx)
+	FeeModeLegacy  FeeMode = "legacy"  // 强制 legacy (gasPrice)，用于未启用 London 的链
+)
+
+// DefaultMaxFeeMultiplier 是 maxFeePerGas 相对 baseFee 的默认倍数，
+// 2 倍可以承受连续 6 个满块的 baseFee 上涨。
+const DefaultMaxFeeMultiplier = 2.0
+
+// ParseFeeMode 解析配置中的交易类型，空字符串视为 auto。
+func ParseFeeMode(s string) (FeeMode, error) {
+	switch mode := FeeMode(strings.ToLower(s)); mode {
+	case "":
+		return FeeModeAuto, nil
+	case FeeModeAuto, FeeModeDynamic, FeeModeLegacy:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown fee mode %q (want auto, dynamic or legacy)", s)
+	}
+}
+
+// FeeBackend 是计算手续费所需的 RPC 接口。
+type FeeBackend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+}
+
+// FeeConfig 控制手续费的计算方式。
+type FeeConfig struct {
+	Mode             FeeMode
+	MaxFeeMultiplier float64 // maxFeePerGas = baseFee * MaxFeeMultiplier + tip，<= 0 时使用默认值
+}
+
+// Fees 是一笔交易的手续费参数。Legacy 为 true 时只有 GasPrice 有效，
+// 否则使用 GasTipCap / GasFeeCap。
+type Fees struct {
+	Legacy    bool
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	BaseFee   *big.Int // 计算时参考的最新区块 baseFee，legacy 链上为 nil
+}
+
+// SuggestFees 根据最新区块和节点建议值计算手续费。
+func SuggestFees(ctx context.Context, backend FeeBackend, cfg FeeConfig) (*Fees, error) {
+	mode := cfg.Mode
+	if mode == "" {
+		mode = FeeModeAuto
+	}
+	if mode == FeeModeLegacy {
+		return suggestLegacy(ctx, backend)
+	}
+
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		if mode == FeeModeDynamic {
+			return nil, errors.New("chain does not support EIP-1559: latest header has no base fee")
+		}
+		return suggestLegacy(ctx, backend)
+	}
+
+	tip, err := backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+	}
+	multiplier := cfg.MaxFeeMultiplier
+	if multiplier <= 0 {
+		multiplier = DefaultMaxFeeMultiplier
+	}
+	feeCap := mulFloat(head.BaseFee, multiplier)
+	feeCap.Add(feeCap, tip)
+
+	return &Fees{
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		BaseFee:   new(big.Int).Set(head.BaseFee),
+	}, nil
+}
+
+func suggestLegacy(ctx context.Context, backend FeeBackend) (*Fees, error) {
+	price, err := backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas price: %w", err)
+	}
+	return &Fees{Legacy: true, GasPrice: price}, nil
+}
+
+// mulFloat 计算 x * f，结果向上取整。
+func mulFloat(x *big.Int, f float64) *big.Int {
+	product := new(big.Float).Mul(new(big.Float).SetInt(x), big.NewFloat(f))
+	out, acc := product.Int(nil)
+	if acc == big.Below {
+		out.Add(out, common.Big1)
+	}
+	return out
+}
+
+// MaxCost 返回按上限计算的手续费：gasLimit * (gasPrice 或 maxFeePerGas)。
+func (f *Fees) MaxCost(gasLimit uint64) *big.Int {
+	price := f.GasFeeCap
+	if f.Legacy {
+		price = f.GasPrice
+	}
+	return new(big.Int).Mul(price, new(big.Int).SetUint64(gasLimit))
+}
+
+// NewTx 用当前手续费参数构造一笔未签名交易。to 为 nil 时是合约创建交易。
+func (f *Fees) NewTx(chainID *big.Int, nonce uint64, to *common.Address, value *big.Int, gasLimit uint64, data []byte) *types.Transaction {
+	if f.Legacy {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			To:       to,
+			Value:    value,
+			Gas:      gasLimit,
+			GasPrice: f.GasPrice,
+			Data:     data,
+		})
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		To:        to,
+		Value:     value,
+		Gas:       gasLimit,
+		GasTipCap: f.GasTipCap,
+		GasFeeCap: f.GasFeeCap,
+		Data:      data,
+	})
+}
+
+// MinReplacementBump 是节点交易池接受同 nonce 替换交易所要求的最小涨幅（百分比）。
+const MinReplacementBump = 10
+
+// BumpFees 为替换交易计算手续费：在旧交易基础上至少上涨 percent%，
+// 并且不低于当前建议值。percent 小于 MinReplacementBump 时按 MinReplacementBump 处理。
+func BumpFees(old *types.Transaction, suggested *Fees, percent uint64) *Fees {
+	if percent < MinReplacementBump {
+		percent = MinReplacementBump
+	}
+	if old.Type() == types.LegacyTxType || suggested.Legacy {
+		price := maxBig(bump(old.GasPrice(), percent), suggested.gasPrice())
+		return &Fees{Legacy: true, GasPrice: price}
+	}
+	tip := maxBig(bump(old.GasTipCap(), percent), suggested.GasTipCap)
+	feeCap := maxBig(bump(old.GasFeeCap(), percent), suggested.GasFeeCap)
+	if feeCap.Cmp(tip) < 0 {
+		feeCap = new(big.Int).Set(tip)
+	}
+	return &Fees{GasTipCap: tip, GasFeeCap: feeCap, BaseFee: suggested.BaseFee}
+}
+
+// gasPrice 返回 legacy 交易应使用的价格。
+func (f *Fees) gasPrice() *big.Int {
+	if f.Legacy {
+		return f.GasPrice
+	}
+	return f.GasFeeCap
+}
+
+// bump 计算 x * (100 + percent) / 100，向上取整。
+func bump(x *big.Int, percent uint64) *big.Int {
+	out := new(big.Int).Mul(x, new(big.Int).SetUint64(100+percent))
+	out.Add(out, big.NewInt(99))
+	return out.Div(out, big.NewInt(100))
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if b != nil && b.Cmp(a) > 0 {
+		return new(big.Int).Set(b)
+	}
+	return new(big.Int).Set(a)
+}
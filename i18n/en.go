@@ -0,0 +1,175 @@
+package i18n
+
+// en 是英文消息目录
+var en = map[string]string{
+	"app.failed": "❌ %v",
+
+	// 配置与连接
+	"config.load_failed":      "failed to load config: %v",
+	"config.network_invalid":  "invalid network config: %v",
+	"config.fee_mode_invalid": "invalid fee mode config: %v",
+	"connect.start":           "🚀 Connecting to %s...",
+	"connect.failed":          "failed to connect to %s: %v",
+	"connect.success":         "✅ Connected to %s! Chain ID: %s",
+	"abi.parse_failed":        "failed to parse contract ABI: %v",
+	"address.invalid":         "invalid address: %q",
+	"rpc.throttled":           "⏱️ %s rate limit: %d/%d requests throttled, waited %s in total",
+
+	// 签名器
+	"signer.config_invalid":       "invalid signer config: %v",
+	"signer.password_file_failed": "failed to read password file: %v",
+	"signer.open_failed":          "failed to open signer: %v",
+	"signer.address":              "📤 Sender: %s",
+
+	// 交易发送与跟踪
+	"tx.nonce_failed": "failed to get nonce: %v",
+	"tx.fees_failed":  "failed to get gas price: %v",
+	"tx.legacy_fees":  "⛽ Gas price: %s Wei (legacy)",
+	"tx.dynamic_fees": "⛽ Base fee: %s Wei, max priority fee: %s Wei, max fee: %s Wei (EIP-1559)",
+	"tx.call_failed":  "%s call failed: %v",
+	"tx.sent":         "✅ %s transaction sent, hash: %s",
+	"tx.link":         "🔗 Transaction: %s",
+	"tx.waiting":      "⏳ Waiting for confirmation...",
+	"tx.reverted":     "%s transaction reverted: %v",
+	"tx.wait_failed":  "failed waiting for %s transaction: %v",
+	"tx.pending":      "⏳ Transaction pending: %s",
+	"tx.stuck":        "⚠️ Transaction not mined for a long time: %s",
+	"tx.replaced":     "🚀 Broadcast replacement transaction: %s",
+	"tx.mined":        "⛏️ Transaction mined in block %d, confirmations: %d",
+	"tx.confirmed":    "✅ Transaction confirmed in block %d, confirmations: %d",
+	"tx.reorged":      "🔀 Transaction's block was reorged out, waiting again: %s",
+	"tx.supplanted":   "❌ Transaction nonce was used by another transaction: %s",
+
+	// send
+	"send.start":           "🚀 Sending transaction...",
+	"send.to":              "📥 Recipient: %s",
+	"send.bad_value":       "invalid amount: %q",
+	"send.value":           "💰 Amount: %s Wei",
+	"send.estimate_failed": "failed to estimate gas: %v",
+	"send.gas_limit":       "⛽ Gas limit: %d",
+	"send.sign_failed":     "failed to sign transaction: %v",
+	"send.send_failed":     "failed to send transaction: %v",
+	"send.success":         "✅ Transaction sent!",
+	"send.hash":            "📊 Transaction hash: %s",
+	"send.reverted":        "transaction reverted: %v",
+	"send.wait_failed":     "failed waiting for confirmation: %v",
+
+	// deploy
+	"deploy.start":       "🔧 Deploying contract...",
+	"deploy.name":        "deployment",
+	"deploy.success":     "✅ Contract deployed!",
+	"deploy.address":     "🏠 Contract address: %s",
+	"deploy.block":       "🔗 Block: %d",
+	"deploy.link":        "🔗 Contract: %s",
+	"deploy.bad_salt":    "invalid salt: %v",
+	"deploy.predicted":   "🔮 CREATE2 address: %s (salt %s)",
+	"deploy.code_failed": "failed to get contract code at %s: %v",
+	"deploy.exists":      "a contract already exists at %s; use --if-absent to reuse it",
+	"deploy.reused":      "♻️ Reusing the existing contract at %s",
+	"deploy.no_proxy":    "the deterministic deployment proxy %s is not deployed on this network: %v",
+
+	// counter
+	"counter.bind_failed":    "failed to bind contract: %v",
+	"counter.get_failed":     "failed to get count: %v",
+	"counter.count":          "📊 Count: %d",
+	"counter.owner_failed":   "failed to get contract owner: %v",
+	"counter.owner":          "👑 Owner: %s",
+	"counter.calling":        "🔄 Calling %s...",
+	"counter.refresh_failed": "failed to get updated count: %v",
+	"counter.updated":        "📊 Updated count: %d",
+	"counter.verify_failed":  "contract code verification failed for %s: %v",
+	"counter.verified":       "✅ Code at %s matches the Counter artifact",
+
+	// deployments
+	"deployments.bad_name":    "invalid deployment name %q: must start with a letter and contain only letters, digits, dots, underscores and hyphens",
+	"deployments.not_found":   "deployment %q not found on chain %d; run deploy first or pass an address with --address: %v",
+	"deployments.resolved":    "📒 %s => %s",
+	"deployments.abi_changed": "⚠️ %s was recorded with ABI version %s but the current binding is %s; the contract may need redeploying",
+	"deployments.save_failed": "failed to write deployments file %s: %v",
+	"deployments.saved":       "📒 Recorded deployment %s in %s",
+	"deployments.load_failed": "failed to read deployments file %s: %v",
+	"deployments.count":       "📒 %d deployments on %s (chain %d)",
+	"deployments.line":        "%-16s %-18s %s block %s",
+
+	// proxy
+	"proxy.verified":        "✅ Code at %s matches the %s artifact",
+	"proxy.deploying_impl":  "🔧 Deploying implementation...",
+	"proxy.impl_name":       "implementation deployment",
+	"proxy.deploying_proxy": "🔧 Deploying proxy...",
+	"proxy.proxy_name":      "proxy deployment",
+	"proxy.address":         "🏠 Proxy address: %s",
+	"proxy.implementation":  "🧩 Current implementation: %s",
+	"proxy.slot_failed":     "failed to read the implementation slot of %s: %v",
+	"proxy.upgrading":       "🔄 Upgrading proxy %s to implementation %s...",
+	"proxy.upgraded":        "✅ Implementation upgraded from %s to %s",
+
+	// events
+	"events.query_failed": "failed to query %s events: %v",
+	"events.count":        "🔍 Found %d events",
+	"events.line":         "📜 Block %d | %-11s | new count: %s | tx: %s",
+
+	// index
+	"index.open_failed": "failed to open event index %s: %v",
+	"index.read_failed": "failed to read event index: %v",
+	"index.head_failed": "failed to get the latest block number: %v",
+	"index.sync_failed": "event backfill failed: %v",
+	"index.shrink":      "⚠️ The node rejected blocks %d-%d, retrying with a smaller range: %v",
+	"index.reorg":       "⚠️ Blocks %d-%d were reorganized; their events were removed and will be indexed again",
+	"index.chunk":       "📦 Blocks %d-%d: %d events",
+	"index.interrupted": "📒 Progress saved up to block %d; run again to continue from there",
+	"index.nothing":     "⏳ No blocks to index yet (starting at block %d)",
+	"index.synced":      "✅ %s indexed from block %d to %d, %d new events",
+	"index.empty":       "no events for %s in the local index; run index sync first",
+	"index.range":       "📒 Index of %s covers blocks %d-%d",
+
+	// block
+	"block.bad_hash":           "invalid block hash: %q",
+	"block.bad_number":         "invalid block number: %q",
+	"block.fetch_failed":       "failed to fetch block: %v",
+	"block.receipts_failed":    "⚠️ failed to fetch receipts, fees not shown: %v",
+	"block.title":              "🔍 Block:",
+	"block.number":             "🔗 Number: %s",
+	"block.hash":               "🔗 Hash: %s",
+	"block.parent_hash":        "🔗 Parent hash: %s",
+	"block.time":               "🔗 Timestamp: %d (%s)",
+	"block.miner":              "⛏️ Miner / fee recipient: %s",
+	"block.gas":                "⛽ Gas used: %d / %d (%.2f%%)",
+	"block.base_fee":           "⛽ Base fee: %s Wei (%s Gwei)",
+	"block.state_root":         "🌳 State root: %s",
+	"block.tx_root":            "🌳 Transactions root: %s",
+	"block.receipt_root":       "🌳 Receipts root: %s",
+	"block.uncle_hash":         "🌳 Uncles hash: %s",
+	"block.withdrawals_root":   "🌳 Withdrawals root: %s",
+	"block.blob_gas_used":      "🫧 Blob gas used: %d",
+	"block.excess_blob_gas":    "🫧 Excess blob gas: %d",
+	"block.parent_beacon_root": "🔗 Parent beacon root: %s",
+	"block.requests_hash":      "🔗 Requests hash: %s",
+	"block.difficulty":         "🎲 Difficulty: %s",
+	"block.mix_digest":         "🎲 MixDigest/PrevRandao: %s",
+	"block.nonce":              "🎲 Nonce: %d",
+	"block.extra":              "📝 Extra data: %s%s",
+	"block.tx_count":           "🔗 Transactions: %d",
+	"block.withdrawal_count":   "🔗 Withdrawals: %d",
+	"block.uncles":             "👪 Uncles (%d):",
+	"block.uncle":              "   [%d] number: %s hash: %s miner: %s",
+	"block.tx_table_title":     "📋 Transactions:",
+	"block.tx_table_header":    "#\tHash\tType\tFrom\tTo\tValue (ETH)\tFee (ETH)",
+	"block.contract_creation":  "contract creation",
+
+	// 本地开发链
+	"dev.network_conflict": "--dev always uses the dev network and cannot be combined with --network %s",
+	"dev.bad_block_time":   "block time must be a non-negative whole number of seconds: %s",
+	"dev.bad_url":          "invalid dev network address %q: %v",
+	"dev.starting":         "🧪 Starting local dev chain on %s ...",
+	"dev.start_failed":     "failed to start local dev chain: %v",
+	"dev.started":          "🧪 Local dev chain started, chain ID: %d, RPC: %s",
+	"dev.mining_instant":   "⛏️ Mining: a block as soon as a transaction arrives",
+	"dev.mining_interval":  "⛏️ Mining: one block every %s",
+	"dev.rpc":              "🔗 HTTP RPC: %s",
+	"dev.ws":               "🔗 WebSocket: %s",
+	"dev.mnemonic":         "🔑 Mnemonic (local development only): %s",
+	"dev.account":          "👤 (%d) %s (%s ETH)",
+	"dev.private_key":      "    private key: %s",
+	"dev.running":          "⏳ Dev chain running, press Ctrl+C to stop",
+	"dev.stopped":          "🛑 Dev chain stopped, chain data discarded",
+}
@@ -0,0 +1,97 @@
+// Package i18n 提供命令行输出的中英文消息目录。
+//
+// 语言按以下顺序选择：--lang 参数、ETH_LANG、LC_ALL、LC_MESSAGES、LANG，
+// 都未设置（或为 C / POSIX）时使用中文。
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Lang 是消息语言
+type Lang string
+
+const (
+	Chinese Lang = "zh"
+	English Lang = "en"
+)
+
+// EnvLang 是选择语言的环境变量，优先于系统的 LANG
+const EnvLang = "ETH_LANG"
+
+// catalogs 是各语言的消息目录，所有语言必须包含相同的键
+var catalogs = map[Lang]map[string]string{
+	Chinese: zh,
+	English: en,
+}
+
+var current atomic.Value // Lang
+
+func init() {
+	current.Store(Chinese)
+}
+
+// Parse 解析语言名称，接受 zh、en 以及 zh_CN.UTF-8、en-US 这样的 locale。
+func Parse(s string) (Lang, bool) {
+	s = strings.ToLower(s)
+	if i := strings.IndexAny(s, "_-."); i >= 0 {
+		s = s[:i]
+	}
+	lang := Lang(s)
+	if _, ok := catalogs[lang]; !ok {
+		return "", false
+	}
+	return lang, true
+}
+
+// Detect 选择语言：explicit 非空时必须是支持的语言，否则依次查看环境变量。
+// 系统 locale 不是支持的语言时（例如 de_DE）使用英文。
+func Detect(explicit string) (Lang, error) {
+	if explicit != "" {
+		lang, ok := Parse(explicit)
+		if !ok {
+			return "", fmt.Errorf("unsupported language %q (want zh or en)", explicit)
+		}
+		return lang, nil
+	}
+	for _, env := range []string{EnvLang, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(env)
+		if value == "" || value == "C" || value == "POSIX" || strings.HasPrefix(value, "C.") {
+			continue
+		}
+		if lang, ok := Parse(value); ok {
+			return lang, nil
+		}
+		return English, nil
+	}
+	return Chinese, nil
+}
+
+// SetLang 设置当前语言。
+func SetLang(lang Lang) {
+	if _, ok := catalogs[lang]; ok {
+		current.Store(lang)
+	}
+}
+
+// Current 返回当前语言。
+func Current() Lang {
+	return current.Load().(Lang)
+}
+
+// T 按当前语言查找消息并用 args 格式化。缺少的键退回中文，仍然找不到时返回键本身。
+func T(key string, args ...any) string {
+	msg, ok := catalogs[Current()][key]
+	if !ok {
+		if msg, ok = zh[key]; !ok {
+			msg = key
+		}
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
@@ -0,0 +1,136 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func keys(m map[string]string) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// 每种语言必须有完全相同的键，否则切换语言时会漏掉消息
+func TestCatalogsHaveSameKeys(t *testing.T) {
+	want := keys(zh)
+	for lang, catalog := range catalogs {
+		got := keys(catalog)
+		for _, k := range want {
+			if _, ok := catalog[k]; !ok {
+				t.Errorf("%s: missing key %q", lang, k)
+			}
+		}
+		for _, k := range got {
+			if _, ok := zh[k]; !ok {
+				t.Errorf("%s: extra key %q not in zh", lang, k)
+			}
+		}
+	}
+}
+
+var verbRE = regexp.MustCompile(`%[-+# 0]*[0-9]*(\.[0-9]+)?[a-zA-Z%]`)
+
+// 同一个键在各语言中的格式化动词必须一致，否则参数会错位
+func TestCatalogsHaveSameVerbs(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for k, msg := range catalog {
+			want := verbRE.FindAllString(zh[k], -1)
+			got := verbRE.FindAllString(msg, -1)
+			if !slices.Equal(got, want) {
+				t.Errorf("%s: key %q has verbs %v, zh has %v", lang, k, got, want)
+			}
+		}
+	}
+}
+
+var callRE = regexp.MustCompile(`i18n\.T\("([^"]+)"`)
+
+// 命令行中引用的每个键都必须在目录里，否则会直接输出键名
+func TestUsedKeysExist(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "cmd", "*", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no command sources found")
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range callRE.FindAllSubmatch(src, -1) {
+			if _, ok := zh[string(m[1])]; !ok {
+				t.Errorf("%s: key %q not in catalog", file, m[1])
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Lang
+		ok   bool
+	}{
+		{"zh", Chinese, true},
+		{"zh_CN.UTF-8", Chinese, true},
+		{"en", English, true},
+		{"en-US", English, true},
+		{"EN_GB.utf8", English, true},
+		{"de_DE", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Parse(%q) = %q, %v; want %q, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for _, env := range []string{EnvLang, "LC_ALL", "LC_MESSAGES", "LANG"} {
+		t.Setenv(env, "")
+	}
+	if lang, err := Detect(""); err != nil || lang != Chinese {
+		t.Errorf("Detect with empty env = %q, %v; want zh", lang, err)
+	}
+	t.Setenv("LANG", "en_US.UTF-8")
+	if lang, _ := Detect(""); lang != English {
+		t.Errorf("Detect with LANG=en_US.UTF-8 = %q; want en", lang)
+	}
+	t.Setenv(EnvLang, "zh")
+	if lang, _ := Detect(""); lang != Chinese {
+		t.Errorf("Detect with %s=zh = %q; want zh", EnvLang, lang)
+	}
+	if lang, _ := Detect("en"); lang != English {
+		t.Errorf("Detect(en) = %q; want en", lang)
+	}
+	if _, err := Detect("fr"); err == nil {
+		t.Error("Detect(fr) succeeded; want error")
+	}
+}
+
+func TestT(t *testing.T) {
+	defer SetLang(Current())
+	SetLang(English)
+	if got := T("counter.count", 3); got != "📊 Count: 3" {
+		t.Errorf("T(counter.count) = %q", got)
+	}
+	SetLang(Chinese)
+	if got := T("counter.count", 3); got != "📊 当前计数: 3" {
+		t.Errorf("T(counter.count) = %q", got)
+	}
+	if got := T("no.such.key"); got != "no.such.key" {
+		t.Errorf("T(no.such.key) = %q", got)
+	}
+}
@@ -0,0 +1,175 @@
+package i18n
+
+// zh 是中文消息目录
+var zh = map[string]string{
+	"app.failed": "❌ %v",
+
+	// 配置与连接
+	"config.load_failed":      "读取配置失败: %v",
+	"config.network_invalid":  "网络配置无效: %v",
+	"config.fee_mode_invalid": "交易类型配置无效: %v",
+	"connect.start":           "🚀 开始连接 %s 网络...",
+	"connect.failed":          "连接 %s 网络失败: %v",
+	"connect.success":         "✅ 成功连接到 %s 网络！链ID: %s",
+	"abi.parse_failed":        "解析合约ABI失败: %v",
+	"address.invalid":         "地址格式错误: %q",
+	"rpc.throttled":           "⏱️ %s 限流: %d/%d 个请求等待过，共等待 %s",
+
+	// 签名器
+	"signer.config_invalid":       "签名器配置无效: %v",
+	"signer.password_file_failed": "读取密码文件失败: %v",
+	"signer.open_failed":          "打开签名器失败: %v",
+	"signer.address":              "📤 发送方地址: %s",
+
+	// 交易发送与跟踪
+	"tx.nonce_failed": "获取Nonce失败: %v",
+	"tx.fees_failed":  "获取Gas价格失败: %v",
+	"tx.legacy_fees":  "⛽ Gas价格: %s Wei (legacy)",
+	"tx.dynamic_fees": "⛽ 基础费用: %s Wei, 小费上限: %s Wei, 费用上限: %s Wei (EIP-1559)",
+	"tx.call_failed":  "调用%s失败: %v",
+	"tx.sent":         "✅ %s交易已发送，交易哈希: %s",
+	"tx.link":         "🔗 交易链接: %s",
+	"tx.waiting":      "⏳ 等待交易确认...",
+	"tx.reverted":     "%s交易执行失败: %v",
+	"tx.wait_failed":  "等待%s交易确认失败: %v",
+	"tx.pending":      "⏳ 交易等待打包: %s",
+	"tx.stuck":        "⚠️ 交易长时间未被打包: %s",
+	"tx.replaced":     "🚀 已广播替换交易: %s",
+	"tx.mined":        "⛏️ 交易已打包，区块高度: %d，确认数: %d",
+	"tx.confirmed":    "✅ 交易已确认，区块高度: %d，确认数: %d",
+	"tx.reorged":      "🔀 交易所在区块被重组，重新等待打包: %s",
+	"tx.supplanted":   "❌ 交易的nonce已被其他交易使用: %s",
+
+	// send
+	"send.start":           "🚀 开始发送交易...",
+	"send.to":              "📥 接收方地址: %s",
+	"send.bad_value":       "转账金额格式错误: %q",
+	"send.value":           "💰 转账金额: %s Wei",
+	"send.estimate_failed": "估算Gas失败: %v",
+	"send.gas_limit":       "⛽ Gas限制: %d",
+	"send.sign_failed":     "签名交易失败: %v",
+	"send.send_failed":     "发送交易失败: %v",
+	"send.success":         "✅ 交易发送成功！",
+	"send.hash":            "📊 交易哈希: %s",
+	"send.reverted":        "交易执行失败: %v",
+	"send.wait_failed":     "等待交易确认失败: %v",
+
+	// deploy
+	"deploy.start":       "🔧 开始部署合约...",
+	"deploy.name":        "部署",
+	"deploy.success":     "✅ 合约部署成功！",
+	"deploy.address":     "🏠 合约地址: %s",
+	"deploy.block":       "🔗 区块高度: %d",
+	"deploy.link":        "🔗 合约链接: %s",
+	"deploy.bad_salt":    "salt 格式错误: %v",
+	"deploy.predicted":   "🔮 CREATE2 地址: %s (salt %s)",
+	"deploy.code_failed": "查询 %s 的合约代码失败: %v",
+	"deploy.exists":      "地址 %s 上已有合约，使用 --if-absent 复用它",
+	"deploy.reused":      "♻️ %s 上已有合约，直接复用",
+	"deploy.no_proxy":    "当前网络没有确定性部署代理 %s: %v",
+
+	// counter
+	"counter.bind_failed":    "绑定合约失败: %v",
+	"counter.get_failed":     "获取计数失败: %v",
+	"counter.count":          "📊 当前计数: %d",
+	"counter.owner_failed":   "获取合约所有者失败: %v",
+	"counter.owner":          "👑 合约所有者: %s",
+	"counter.calling":        "🔄 调用%s方法...",
+	"counter.refresh_failed": "获取更新后的计数失败: %v",
+	"counter.updated":        "📊 更新后的计数: %d",
+	"counter.verify_failed":  "合约 %s 代码校验失败: %v",
+	"counter.verified":       "✅ %s 的代码与 Counter 编译产物一致",
+
+	// 部署记录
+	"deployments.bad_name":    "部署名称 %q 无效：必须以字母开头，只能包含字母、数字、点、下划线和连字符",
+	"deployments.not_found":   "找不到部署 %q（链 %d），先运行 deploy 或用 --address 指定地址: %v",
+	"deployments.resolved":    "📒 %s => %s",
+	"deployments.abi_changed": "⚠️ %s 记录的 ABI 版本 %s 与当前绑定 %s 不同，合约可能需要重新部署",
+	"deployments.save_failed": "写入部署记录 %s 失败: %v",
+	"deployments.saved":       "📒 已记录部署 %s: %s",
+	"deployments.load_failed": "读取部署记录 %s 失败: %v",
+	"deployments.count":       "📒 共有 %d 个部署（%s，链 %d）",
+	"deployments.line":        "%-16s %-18s %s 区块 %s",
+
+	// proxy
+	"proxy.verified":        "✅ %s 的代码与 %s 编译产物一致",
+	"proxy.deploying_impl":  "🔧 部署实现合约...",
+	"proxy.impl_name":       "部署实现合约",
+	"proxy.deploying_proxy": "🔧 部署代理合约...",
+	"proxy.proxy_name":      "部署代理合约",
+	"proxy.address":         "🏠 代理地址: %s",
+	"proxy.implementation":  "🧩 当前实现合约: %s",
+	"proxy.slot_failed":     "读取 %s 的实现槽失败: %v",
+	"proxy.upgrading":       "🔄 升级代理 %s 到实现合约 %s...",
+	"proxy.upgraded":        "✅ 实现合约已从 %s 升级为 %s",
+
+	// events
+	"events.query_failed": "查询%s事件失败: %v",
+	"events.count":        "🔍 共找到 %d 个事件",
+	"events.line":         "📜 区块 %d | %-11s | 新计数: %s | 交易: %s",
+
+	// index
+	"index.open_failed": "打开事件索引 %s 失败: %v",
+	"index.read_failed": "读取事件索引失败: %v",
+	"index.head_failed": "获取最新区块号失败: %v",
+	"index.sync_failed": "回填事件失败: %v",
+	"index.shrink":      "⚠️ 节点拒绝了区块 %d-%d 的查询，缩小范围重试: %v",
+	"index.reorg":       "⚠️ 区块 %d-%d 已被重组，删除其中的事件后重新索引",
+	"index.chunk":       "📦 区块 %d-%d: %d 个事件",
+	"index.interrupted": "📒 进度已保存到区块 %d，再次运行会从这里继续",
+	"index.nothing":     "⏳ 还没有可以索引的区块（从区块 %d 开始）",
+	"index.synced":      "✅ %s 已索引区块 %d-%d，本次新增 %d 个事件",
+	"index.empty":       "本地索引中没有 %s 的事件，先运行 index sync",
+	"index.range":       "📒 %s 的索引覆盖区块 %d-%d",
+
+	// block
+	"block.bad_hash":           "区块哈希格式错误: %q",
+	"block.bad_number":         "区块号格式错误: %q",
+	"block.fetch_failed":       "获取区块信息失败: %v",
+	"block.receipts_failed":    "⚠️ 获取交易回执失败，不显示手续费: %v",
+	"block.title":              "🔍 区块信息:",
+	"block.number":             "🔗 区块号: %s",
+	"block.hash":               "🔗 区块哈希: %s",
+	"block.parent_hash":        "🔗 父区块哈希: %s",
+	"block.time":               "🔗 区块时间戳: %d (%s)",
+	"block.miner":              "⛏️ 矿工/出块地址: %s",
+	"block.gas":                "⛽ Gas使用: %d / %d (%.2f%%)",
+	"block.base_fee":           "⛽ 基础费用: %s Wei (%s Gwei)",
+	"block.state_root":         "🌳 状态根: %s",
+	"block.tx_root":            "🌳 交易根: %s",
+	"block.receipt_root":       "🌳 回执根: %s",
+	"block.uncle_hash":         "🌳 叔块哈希: %s",
+	"block.withdrawals_root":   "🌳 提款根: %s",
+	"block.blob_gas_used":      "🫧 Blob Gas使用: %d",
+	"block.excess_blob_gas":    "🫧 超额 Blob Gas: %d",
+	"block.parent_beacon_root": "🔗 父信标区块根: %s",
+	"block.requests_hash":      "🔗 请求哈希: %s",
+	"block.difficulty":         "🎲 难度: %s",
+	"block.mix_digest":         "🎲 MixDigest/PrevRandao: %s",
+	"block.nonce":              "🎲 Nonce: %d",
+	"block.extra":              "📝 附加数据: %s%s",
+	"block.tx_count":           "🔗 交易数量: %d",
+	"block.withdrawal_count":   "🔗 提款数量: %d",
+	"block.uncles":             "👪 叔块 (%d):",
+	"block.uncle":              "   [%d] 区块号: %s 哈希: %s 矿工: %s",
+	"block.tx_table_title":     "📋 交易列表:",
+	"block.tx_table_header":    "#\t哈希\t类型\t发送方\t接收方\t金额 (ETH)\t手续费 (ETH)",
+	"block.contract_creation":  "合约创建",
+
+	// 本地开发链
+	"dev.network_conflict": "--dev 只能使用 dev 网络，不能与 --network %s 同时使用",
+	"dev.bad_block_time":   "出块间隔必须是非负整秒: %s",
+	"dev.bad_url":          "dev 网络的地址无效 %q: %v",
+	"dev.starting":         "🧪 正在启动本地开发链，监听 %s ...",
+	"dev.start_failed":     "启动本地开发链失败: %v",
+	"dev.started":          "🧪 本地开发链已启动，链ID: %d，RPC: %s",
+	"dev.mining_instant":   "⛏️ 出块方式: 收到交易立即出块",
+	"dev.mining_interval":  "⛏️ 出块方式: 每 %s 出一个块",
+	"dev.rpc":              "🔗 HTTP RPC: %s",
+	"dev.ws":               "🔗 WebSocket: %s",
+	"dev.mnemonic":         "🔑 助记词（仅限本地开发）: %s",
+	"dev.account":          "👤 (%d) %s (%s ETH)",
+	"dev.private_key":      "    私钥: %s",
+	"dev.running":          "⏳ 开发链运行中，按 Ctrl+C 停止",
+	"dev.stopped":          "🛑 开发链已停止，链上数据已丢弃",
+}
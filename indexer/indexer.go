@@ -0,0 +1,246 @@
+// Package indexer 把 Counter 合约的 Incremented / Decremented / Reset 事件回填到本地 LevelDB。
+//
+// 从部署区块开始按区块范围分段调用 eth_getLogs，一次查询三种事件：节点拒绝过大的范围时
+// 把分段减半重试，成功后再逐步放大。每段的事件和进度在同一批次写入，中断后从最后的进度继续。
+// 继续之前先确认已索引的区块没有被重组，被重组时删除受影响的事件后重新索引。
+// 可升级 Counter 的代理发出同样的事件，也可以用它索引。
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/counter"
+)
+
+// 默认分段大小（区块数）
+const (
+	DefaultChunk    = 2000
+	DefaultMaxChunk = 10000
+)
+
+// maxReorgRetries 是同一分段在查询期间被重组后最多重新查询的次数
+const maxReorgRetries = 3
+
+// Progress 是回填进度：成功索引了 [From, To] 中的 Events 个事件；
+// 或者 Err 非 nil，表示节点拒绝了这个范围，将缩小分段重试；
+// 或者 Reorg 为 true，表示 [From, To] 已被重组，其中的事件已删除，将重新索引。
+type Progress struct {
+	From   uint64
+	To     uint64
+	Events int
+	Err    error
+	Reorg  bool
+}
+
+// Backend 是 Indexer 需要的节点接口：查询日志，并用区块头确认已索引的区块仍在链上
+type Backend interface {
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Indexer 把一个合约的事件回填到 Store。
+type Indexer struct {
+	Backend    Backend
+	Store      *Store
+	Contract   common.Address
+	Start      uint64         // 没有进度时的起始区块，通常是部署区块；已有进度时忽略
+	Chunk      uint64         // 初始分段大小，默认 DefaultChunk
+	MaxChunk   uint64         // 分段放大的上限，默认 DefaultMaxChunk
+	OnProgress func(Progress) // 可以为 nil
+}
+
+// Sync 从进度（没有时从 Start）回填到区块 to（含），返回新的进度。
+// 返回错误时已完成的分段都已保存，再次调用会从中断处继续。
+func (ix *Indexer) Sync(ctx context.Context, to uint64) (*Checkpoint, error) {
+	cp, err := ix.Store.Checkpoint(ix.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if cp == nil {
+		cp = &Checkpoint{Start: ix.Start, Next: ix.Start}
+	}
+	filterer, err := counter.NewCounterFilterer(ix.Contract, ix.Backend)
+	if err != nil {
+		return cp, err
+	}
+	parsed, err := counter.CounterMetaData.GetAbi()
+	if err != nil {
+		return cp, err
+	}
+	names := map[common.Hash]string{}
+	var ids []common.Hash
+	for _, name := range []string{"Incremented", "Decremented", "Reset"} {
+		id := parsed.Events[name].ID
+		names[id] = name
+		ids = append(ids, id)
+	}
+
+	maxChunk := ix.MaxChunk
+	if maxChunk == 0 {
+		maxChunk = DefaultMaxChunk
+	}
+	chunk := ix.Chunk
+	if chunk == 0 {
+		chunk = DefaultChunk
+	}
+	chunk = min(chunk, maxChunk)
+
+	reorgs := 0 // 同一分段连续因重组重新查询的次数
+	for {
+		// 继续之前（包括每个分段之间）确认已索引的最后一个区块仍在链上
+		if cp.Hash != (common.Hash{}) {
+			if cp, err = ix.rewind(ctx, cp); err != nil {
+				return cp, err
+			}
+		}
+		if cp.Next > to {
+			return cp, nil
+		}
+		end := to
+		if to-cp.Next >= chunk {
+			end = cp.Next + chunk - 1
+		}
+		// 查询前后各取一次分段末尾的区块头：两次相同说明查询期间 [Next, end] 没有被重组
+		// （区块哈希链接了之前的所有区块），不同时重新查询这一段
+		before, err := ix.header(ctx, end)
+		if err != nil {
+			return cp, err
+		}
+		logs, err := ix.Backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(cp.Next),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: []common.Address{ix.Contract},
+			Topics:    [][]common.Hash{ids},
+		})
+		if err != nil {
+			if ctx.Err() == nil && end > cp.Next && chain.IsRangeTooLarge(err) {
+				ix.report(Progress{From: cp.Next, To: end, Err: err})
+				chunk = (end - cp.Next + 1) / 2
+				continue
+			}
+			return cp, fmt.Errorf("get logs %d-%d: %w", cp.Next, end, err)
+		}
+		header, err := ix.header(ctx, end)
+		if err != nil {
+			return cp, err
+		}
+		if header.Hash() != before.Hash() {
+			if reorgs++; reorgs > maxReorgRetries {
+				return cp, fmt.Errorf("blocks %d-%d keep being reorganized while indexing", cp.Next, end)
+			}
+			continue
+		}
+		reorgs = 0
+		events, err := decode(filterer, names, logs)
+		if err != nil {
+			return cp, err
+		}
+		next := &Checkpoint{Start: cp.Start, Next: end + 1, Hash: header.Hash()}
+		if err := ix.Store.Commit(ix.Contract, events, *next); err != nil {
+			return cp, err
+		}
+		ix.report(Progress{From: cp.Next, To: end, Events: len(events)})
+
+		// 整段成功后放大分段，尽快回到节点允许的最大范围
+		if end-cp.Next+1 == chunk {
+			chunk = min(chunk*2, maxChunk)
+		}
+		cp = next
+	}
+}
+
+// rewind 确认已索引的最后一个区块仍在链上。已被重组时退回到仍在链上的最近一个已记录区块
+// （都不在时退回到 Start），删除之后的事件，返回新的进度。
+func (ix *Indexer) rewind(ctx context.Context, cp *Checkpoint) (*Checkpoint, error) {
+	canonical := func(number uint64, hash common.Hash) (bool, error) {
+		header, err := ix.header(ctx, number)
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil // 重组后的链更短
+		}
+		if err != nil {
+			return false, err
+		}
+		return header.Hash() == hash, nil
+	}
+	if ok, err := canonical(cp.Next-1, cp.Hash); err != nil || ok {
+		return cp, err
+	}
+	number, hash, ok, err := ix.Store.ancestor(ix.Contract, canonical)
+	if err != nil {
+		return cp, err
+	}
+	back := &Checkpoint{Start: cp.Start, Next: cp.Start}
+	if ok {
+		back.Next, back.Hash = number+1, hash
+	}
+	if err := ix.Store.Rollback(ix.Contract, *back); err != nil {
+		return cp, err
+	}
+	ix.report(Progress{From: back.Next, To: cp.Next - 1, Reorg: true})
+	return back, nil
+}
+
+func (ix *Indexer) header(ctx context.Context, number uint64) (*types.Header, error) {
+	header, err := ix.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("get header %d: %w", number, err)
+	}
+	return header, nil
+}
+
+func (ix *Indexer) report(p Progress) {
+	if ix.OnProgress != nil {
+		ix.OnProgress(p)
+	}
+}
+
+// decode 用 Counter 绑定解析日志，names 是事件 topic 到事件名的映射。忽略因重组被移除的日志。
+func decode(filterer *counter.CounterFilterer, names map[common.Hash]string, logs []types.Log) ([]Event, error) {
+	events := make([]Event, 0, len(logs))
+	for _, log := range logs {
+		if log.Removed || len(log.Topics) == 0 {
+			continue
+		}
+		ev := Event{
+			Name:        names[log.Topics[0]],
+			BlockNumber: log.BlockNumber,
+			BlockHash:   log.BlockHash,
+			TxHash:      log.TxHash,
+			TxIndex:     log.TxIndex,
+			LogIndex:    log.Index,
+		}
+		switch ev.Name {
+		case "Incremented":
+			parsed, err := filterer.ParseIncremented(log)
+			if err != nil {
+				return nil, err
+			}
+			ev.NewCount = parsed.NewCount
+		case "Decremented":
+			parsed, err := filterer.ParseDecremented(log)
+			if err != nil {
+				return nil, err
+			}
+			ev.NewCount = parsed.NewCount
+		case "Reset":
+			parsed, err := filterer.ParseReset(log)
+			if err != nil {
+				return nil, err
+			}
+			ev.NewCount = parsed.NewCount
+		default:
+			continue
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
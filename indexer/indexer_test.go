@@ -0,0 +1,462 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/internal/fakerpc"
+	"github.com/clc781032855/go_ethereum/internal/simtest"
+)
+
+// fixture 是模拟链上的 Counter 合约
+type fixture struct {
+	sim     *simulated.Backend
+	auth    *bind.TransactOpts
+	address common.Address
+	deploy  uint64 // 部署区块
+	counter *counter.Counter
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	auth := simtest.NewAccount(t)
+	sim := simtest.NewBackend(t, nil, auth.From)
+	address, tx, c, err := counter.DeployCounter(auth, sim.Client(), auth.From)
+	if err != nil {
+		t.Fatalf("DeployCounter: %v", err)
+	}
+	deploy := simtest.Mine(t, sim, tx).BlockNumber.Uint64()
+	return &fixture{sim: sim, auth: auth, address: address, deploy: deploy, counter: c}
+}
+
+// call 发送一笔交易并单独出块。
+func (f *fixture) call(t *testing.T, fn func(*bind.TransactOpts) (*types.Transaction, error)) {
+	t.Helper()
+	simtest.Transact(t, f.sim, f.auth, fn)
+}
+
+// empty 出 n 个空块。
+func (f *fixture) empty(n int) {
+	for i := 0; i < n; i++ {
+		f.sim.Commit()
+	}
+}
+
+// reorg 用从区块 fork 分叉的新链替换当前链，新链高度至少到 head。
+// 被移除的交易重新打包进新链的区块。
+func (f *fixture) reorg(t *testing.T, fork, head uint64) {
+	t.Helper()
+	header, err := f.sim.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(fork))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.sim.Fork(header.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	f.empty(int(head-fork) + 1)
+}
+
+// checkCanonical 确认索引中到区块 head 为止有 n 个计数依次为 1..n 的事件，并且都来自当前链。
+func (f *fixture) checkCanonical(t *testing.T, store *Store, head uint64, n int) {
+	t.Helper()
+	events, err := store.Events(f.address, 0, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != n {
+		t.Fatalf("got %d events; want %d", len(events), n)
+	}
+	for i, ev := range events {
+		if ev.NewCount.Int64() != int64(i+1) {
+			t.Errorf("event %d count = %s; want %d", i, ev.NewCount, i+1)
+		}
+		header, err := f.sim.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(ev.BlockNumber))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ev.BlockHash != header.Hash() {
+			t.Errorf("event in block %d has hash %s from the old chain", ev.BlockNumber, ev.BlockHash.Hex())
+		}
+	}
+}
+
+func (f *fixture) head(t *testing.T) uint64 {
+	t.Helper()
+	n, err := f.sim.Client().BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// limitedFilterer 模拟限制 eth_getLogs 范围的节点，并记录每次查询的范围
+type limitedFilterer struct {
+	Backend
+	maxRange uint64
+	fail     error // 非 nil 时所有查询都返回它
+	queries  [][2]uint64
+}
+
+func (l *limitedFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	l.queries = append(l.queries, [2]uint64{from, to})
+	if l.fail != nil {
+		return nil, l.fail
+	}
+	if to-from+1 > l.maxRange {
+		return nil, errors.New("exceed maximum block range: 4")
+	}
+	return l.Backend.FilterLogs(ctx, q)
+}
+
+// rangeLimitedNode 返回模拟节点的响应：eth_getLogs 的范围超过 maxRange 时按 Infura 的方式
+// 返回 -32005，否则返回空结果；区块头只有区块号
+func rangeLimitedNode(maxRange uint64) fakerpc.Handler {
+	return func(method string, params json.RawMessage) fakerpc.Response {
+		if method == "eth_getBlockByNumber" {
+			var args []json.RawMessage
+			var number hexutil.Uint64
+			if json.Unmarshal(params, &args) != nil || len(args) == 0 || json.Unmarshal(args[0], &number) != nil {
+				return fakerpc.Response{Error: &fakerpc.Error{Code: -32602, Message: "invalid params"}}
+			}
+			return fakerpc.Response{Result: &types.Header{Number: new(big.Int).SetUint64(uint64(number)), Difficulty: new(big.Int)}}
+		}
+		var query []struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		}
+		if method != "eth_getLogs" || json.Unmarshal(params, &query) != nil || len(query) != 1 {
+			return fakerpc.Response{Error: &fakerpc.Error{Code: -32601, Message: "unexpected " + method}}
+		}
+		if uint64(query[0].ToBlock-query[0].FromBlock)+1 > maxRange {
+			return fakerpc.Response{Error: &fakerpc.Error{Code: -32005, Message: "query returned more than 10000 results"}}
+		}
+		return fakerpc.Response{Result: []types.Log{}}
+	}
+}
+
+func openStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+// 经过 chain.Backend 时，范围过大的 -32005 不能被当作限流在节点间重试
+func TestSyncShrinksThroughBackend(t *testing.T) {
+	node := fakerpc.New(t, rangeLimitedNode(4))
+	backend := chain.NewBackend([]chain.Endpoint{{URL: node.URL, Client: node.Dial(t)}}, chain.BackendOptions{
+		MaxAttempts: chain.DefaultMaxAttempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    time.Millisecond,
+	})
+	var accepted, rejected int
+	ix := &Indexer{
+		Backend:  backend,
+		Store:    openStore(t),
+		Contract: common.HexToAddress("0x01"),
+		Chunk:    16,
+		MaxChunk: 16,
+		OnProgress: func(p Progress) {
+			if p.Err != nil {
+				rejected++
+			} else {
+				accepted++
+			}
+		},
+	}
+	cp, err := ix.Sync(context.Background(), 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Next != 16 {
+		t.Errorf("checkpoint next = %d; want 16", cp.Next)
+	}
+	if rejected == 0 {
+		t.Fatal("no range was rejected; the test does not exercise shrinking")
+	}
+	// 每个范围只查询一次：被拒绝的范围没有重试
+	if got := node.Calls("eth_getLogs"); got != accepted+rejected {
+		t.Errorf("eth_getLogs called %d times for %d accepted and %d rejected ranges", got, accepted, rejected)
+	}
+}
+
+// checkEvents 确认索引中的事件名和计数依次为 want。
+func checkEvents(t *testing.T, store *Store, contract common.Address, want []string, counts []int64) {
+	t.Helper()
+	events, err := store.Events(contract, 0, ^uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events; want %d", len(events), len(want))
+	}
+	for i, ev := range events {
+		if ev.Name != want[i] || ev.NewCount.Int64() != counts[i] {
+			t.Errorf("event %d = %s(%s); want %s(%d)", i, ev.Name, ev.NewCount, want[i], counts[i])
+		}
+		if i > 0 && ev.BlockNumber <= events[i-1].BlockNumber {
+			t.Errorf("event %d at block %d is not after block %d", i, ev.BlockNumber, events[i-1].BlockNumber)
+		}
+	}
+}
+
+func TestSyncShrinksRange(t *testing.T) {
+	f := newFixture(t)
+	f.call(t, f.counter.Increment)
+	f.empty(5)
+	f.call(t, f.counter.Increment)
+	f.call(t, f.counter.Decrement)
+	f.empty(10)
+	f.call(t, f.counter.Reset)
+	head := f.head(t)
+
+	store := openStore(t)
+	backend := &limitedFilterer{Backend: f.sim.Client(), maxRange: 4}
+	var rejected int
+	ix := &Indexer{
+		Backend:  backend,
+		Store:    store,
+		Contract: f.address,
+		Start:    f.deploy,
+		Chunk:    16,
+		MaxChunk: 16,
+		OnProgress: func(p Progress) {
+			if p.Err != nil {
+				rejected++
+			}
+		},
+	}
+	cp, err := ix.Sync(context.Background(), head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Start != f.deploy || cp.Next != head+1 {
+		t.Errorf("checkpoint = %+v; want start %d next %d", cp, f.deploy, head+1)
+	}
+	if rejected == 0 {
+		t.Error("no range was rejected; the test does not exercise shrinking")
+	}
+
+	// 每个被接受的范围都不超过节点限制，且首尾相接覆盖 [deploy, head]
+	next := f.deploy
+	for _, q := range backend.queries {
+		if q[1]-q[0]+1 > backend.maxRange {
+			continue
+		}
+		if q[0] != next {
+			t.Fatalf("accepted query %v does not start at %d", q, next)
+		}
+		next = q[1] + 1
+	}
+	if next != head+1 {
+		t.Errorf("accepted queries end at %d; want %d", next-1, head)
+	}
+
+	checkEvents(t, store, f.address,
+		[]string{"Incremented", "Incremented", "Decremented", "Reset"},
+		[]int64{1, 2, 1, 0})
+}
+
+func TestSyncRollsBackReorg(t *testing.T) {
+	f := newFixture(t)
+	f.call(t, f.counter.Increment)
+	fork := f.head(t)
+	f.call(t, f.counter.Increment)
+	f.call(t, f.counter.Increment)
+	head := f.head(t)
+
+	store := openStore(t)
+	var reorgs []Progress
+	ix := &Indexer{
+		Backend:  f.sim.Client(),
+		Store:    store,
+		Contract: f.address,
+		Start:    f.deploy,
+		Chunk:    1, // 每个区块一个分段，每个区块都记录哈希
+		MaxChunk: 1,
+		OnProgress: func(p Progress) {
+			if p.Reorg {
+				reorgs = append(reorgs, p)
+			}
+		},
+	}
+	if _, err := ix.Sync(context.Background(), head); err != nil {
+		t.Fatal(err)
+	}
+	checkEvents(t, store, f.address, []string{"Incremented", "Incremented", "Incremented"}, []int64{1, 2, 3})
+
+	f.reorg(t, fork, head)
+
+	cp, err := ix.Sync(context.Background(), head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reorgs) != 1 || reorgs[0].From != fork+1 || reorgs[0].To != head {
+		t.Fatalf("reorg progress = %+v; want blocks %d-%d", reorgs, fork+1, head)
+	}
+	canonical, err := f.sim.Client().HeaderByNumber(context.Background(), new(big.Int).SetUint64(head))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Next != head+1 || cp.Hash != canonical.Hash() {
+		t.Errorf("checkpoint = %+v; want next %d hash %s", cp, head+1, canonical.Hash().Hex())
+	}
+	// 旧链上 fork 之后的事件已删除，索引中只有新链上的事件
+	f.checkCanonical(t, store, head, 3)
+}
+
+// reorgingFilterer 在第一次 eth_getLogs 返回之后调用 reorg，模拟查询期间发生的重组
+type reorgingFilterer struct {
+	Backend
+	reorg   func()
+	queries int
+}
+
+func (r *reorgingFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	r.queries++
+	logs, err := r.Backend.FilterLogs(ctx, q)
+	if r.reorg != nil {
+		reorg := r.reorg
+		r.reorg = nil
+		reorg()
+	}
+	return logs, err
+}
+
+// 分段中间的区块在查询期间被重组时，查到的旧链事件不能写入索引
+func TestSyncReorgDuringQuery(t *testing.T) {
+	f := newFixture(t)
+	f.call(t, f.counter.Increment)
+	fork := f.head(t)
+	f.call(t, f.counter.Increment)
+	f.call(t, f.counter.Increment)
+	f.empty(2) // 分段末尾的区块没有事件
+	head := f.head(t)
+
+	store := openStore(t)
+	backend := &reorgingFilterer{Backend: f.sim.Client(), reorg: func() { f.reorg(t, fork, head) }}
+	ix := &Indexer{Backend: backend, Store: store, Contract: f.address, Start: f.deploy, Chunk: 100}
+	cp, err := ix.Sync(context.Background(), head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend.queries != 2 {
+		t.Errorf("%d eth_getLogs queries; want 2 (the reorganized chunk is queried again)", backend.queries)
+	}
+	if cp.Next != head+1 {
+		t.Errorf("checkpoint next = %d; want %d", cp.Next, head+1)
+	}
+	f.checkCanonical(t, store, head, 3)
+}
+
+func TestSyncResumes(t *testing.T) {
+	f := newFixture(t)
+	f.call(t, f.counter.Increment)
+	f.call(t, f.counter.Increment)
+	first := f.head(t)
+
+	store := openStore(t)
+	backend := &limitedFilterer{Backend: f.sim.Client(), maxRange: 100}
+	ix := &Indexer{Backend: backend, Store: store, Contract: f.address, Start: f.deploy}
+	if _, err := ix.Sync(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+
+	// 节点故障时返回错误，进度不变
+	f.call(t, f.counter.Decrement)
+	f.empty(3)
+	f.call(t, f.counter.Increment)
+	head := f.head(t)
+	backend.fail = errors.New("connection refused")
+	if _, err := ix.Sync(context.Background(), head); err == nil {
+		t.Fatal("Sync succeeded with a failing backend")
+	}
+	cp, err := store.Checkpoint(f.address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Next != first+1 {
+		t.Fatalf("checkpoint next = %d after failure; want %d", cp.Next, first+1)
+	}
+
+	// 恢复后从进度继续，即使 Start 不同也不重新索引
+	backend.fail, backend.queries = nil, nil
+	ix.Start = 0
+	if _, err := ix.Sync(context.Background(), head); err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.queries) == 0 || backend.queries[0][0] != first+1 {
+		t.Errorf("resumed queries = %v; want to start at %d", backend.queries, first+1)
+	}
+	checkEvents(t, store, f.address,
+		[]string{"Incremented", "Incremented", "Decremented", "Incremented"},
+		[]int64{1, 2, 1, 2})
+
+	// 已经索引到最新区块时不再查询
+	backend.queries = nil
+	if _, err := ix.Sync(context.Background(), head); err != nil {
+		t.Fatal(err)
+	}
+	if len(backend.queries) != 0 {
+		t.Errorf("up-to-date Sync queried %v", backend.queries)
+	}
+}
+
+func TestStoreEventsRange(t *testing.T) {
+	store := openStore(t)
+	a, b := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	events := []Event{
+		{Name: "Incremented", NewCount: big.NewInt(1), BlockNumber: 5, TxIndex: 0, LogIndex: 0},
+		{Name: "Incremented", NewCount: big.NewInt(2), BlockNumber: 5, TxIndex: 1, LogIndex: 1},
+		{Name: "Reset", NewCount: big.NewInt(0), BlockNumber: 7, TxIndex: 0, LogIndex: 0},
+	}
+	if err := store.Commit(a, events, Checkpoint{Start: 5, Next: 8}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Commit(b, events[:1], Checkpoint{Start: 5, Next: 6}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		contract common.Address
+		from, to uint64
+		want     int
+	}{
+		{a, 0, 100, 3},
+		{a, 5, 5, 2},
+		{a, 6, 7, 1},
+		{a, 8, 100, 0},
+		{b, 0, 100, 1},
+	}
+	for _, tt := range tests {
+		got, err := store.Events(tt.contract, tt.from, tt.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != tt.want {
+			t.Errorf("Events(%s, %d, %d) = %d events; want %d", tt.contract.Hex(), tt.from, tt.to, len(got), tt.want)
+		}
+	}
+
+	cp, err := store.Checkpoint(common.HexToAddress("0x03"))
+	if err != nil || cp != nil {
+		t.Errorf("Checkpoint of an unknown contract = %v, %v; want nil", cp, err)
+	}
+}
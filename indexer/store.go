@@ -0,0 +1,184 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// 键的前缀：
+//
+//	e + 合约地址(20) + 区块号(8) + 交易序号(4) + 日志序号(4) -> Event（JSON）
+//	h + 合约地址(20) + 区块号(8)                          -> 区块哈希(32)
+//	c + 合约地址(20)                                      -> Checkpoint（JSON）
+//
+// 整数都是大端序，同一合约的事件按链上顺序排列，可以按区块范围迭代。
+// h 记录每个已提交分段最后一个区块的哈希，用来在继续索引前发现重组。
+var (
+	eventPrefix      = []byte("e")
+	hashPrefix       = []byte("h")
+	checkpointPrefix = []byte("c")
+)
+
+// Event 是索引中的一条 Counter 事件
+type Event struct {
+	Name        string      `json:"event"` // Incremented、Decremented 或 Reset
+	NewCount    *big.Int    `json:"newCount"`
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+	TxHash      common.Hash `json:"txHash"`
+	TxIndex     uint        `json:"txIndex"`
+	LogIndex    uint        `json:"logIndex"`
+}
+
+// Checkpoint 是一个合约的索引进度：[Start, Next) 之间的区块已经全部索引
+type Checkpoint struct {
+	Start uint64      `json:"start"`          // 第一次索引的起始区块（通常是部署区块）
+	Next  uint64      `json:"next"`           // 下一个要索引的区块
+	Hash  common.Hash `json:"hash,omitempty"` // 区块 Next-1 的哈希，还没有索引任何区块时为空
+}
+
+// Store 是保存在本地 LevelDB 中的事件索引，可以同时保存多个合约。
+type Store struct {
+	db *leveldb.DB
+}
+
+// Open 打开（不存在时创建）目录 dir 中的索引。同一目录同时只能被一个进程打开。
+func Open(dir string) (*Store, error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open index %s: %w", dir, err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close 关闭数据库。
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func eventKey(contract common.Address, blockNumber uint64, txIndex, logIndex uint) []byte {
+	key := make([]byte, 0, len(eventPrefix)+common.AddressLength+16)
+	key = append(key, eventPrefix...)
+	key = append(key, contract.Bytes()...)
+	key = binary.BigEndian.AppendUint64(key, blockNumber)
+	key = binary.BigEndian.AppendUint32(key, uint32(txIndex))
+	key = binary.BigEndian.AppendUint32(key, uint32(logIndex))
+	return key
+}
+
+func hashKey(contract common.Address, blockNumber uint64) []byte {
+	key := make([]byte, 0, len(hashPrefix)+common.AddressLength+8)
+	key = append(key, hashPrefix...)
+	key = append(key, contract.Bytes()...)
+	return binary.BigEndian.AppendUint64(key, blockNumber)
+}
+
+func checkpointKey(contract common.Address) []byte {
+	return append(append([]byte{}, checkpointPrefix...), contract.Bytes()...)
+}
+
+// Checkpoint 返回合约的索引进度，从未索引过时返回 nil。
+func (s *Store) Checkpoint(contract common.Address) (*Checkpoint, error) {
+	data, err := s.db.Get(checkpointKey(contract), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cp := new(Checkpoint)
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("parse checkpoint of %s: %w", contract.Hex(), err)
+	}
+	return cp, nil
+}
+
+// Commit 在一次批量写入中保存 events、区块 cp.Next-1 的哈希并把进度推进到 cp，
+// 中断时不会出现有事件没进度或有进度没事件。同一事件重复写入会覆盖旧值。
+func (s *Store) Commit(contract common.Address, events []Event, cp Checkpoint) error {
+	batch := new(leveldb.Batch)
+	for i := range events {
+		data, err := json.Marshal(&events[i])
+		if err != nil {
+			return err
+		}
+		batch.Put(eventKey(contract, events[i].BlockNumber, events[i].TxIndex, events[i].LogIndex), data)
+	}
+	if cp.Next > cp.Start && cp.Hash != (common.Hash{}) {
+		batch.Put(hashKey(contract, cp.Next-1), cp.Hash.Bytes())
+	}
+	return s.writeCheckpoint(batch, contract, cp)
+}
+
+// Rollback 删除合约在区块 cp.Next 及之后的事件和区块哈希，并把进度退回到 cp，用于处理重组。
+func (s *Store) Rollback(contract common.Address, cp Checkpoint) error {
+	batch := new(leveldb.Batch)
+	for _, r := range []*util.Range{
+		{Start: eventKey(contract, cp.Next, 0, 0), Limit: append(eventKey(contract, math.MaxUint64, math.MaxUint32, math.MaxUint32), 0)},
+		{Start: hashKey(contract, cp.Next), Limit: append(hashKey(contract, math.MaxUint64), 0)},
+	} {
+		iter := s.db.NewIterator(r, nil)
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+	return s.writeCheckpoint(batch, contract, cp)
+}
+
+func (s *Store) writeCheckpoint(batch *leveldb.Batch, contract common.Address, cp Checkpoint) error {
+	data, err := json.Marshal(&cp)
+	if err != nil {
+		return err
+	}
+	batch.Put(checkpointKey(contract), data)
+	return s.db.Write(batch, nil)
+}
+
+// ancestor 从最新的区块开始往前，依次用 canonical 检查已记录的区块哈希是否仍在链上，
+// 返回第一个仍在链上的区块和它的哈希。没有记录或都已被重组时 ok 为 false。
+func (s *Store) ancestor(contract common.Address, canonical func(number uint64, hash common.Hash) (bool, error)) (number uint64, hash common.Hash, ok bool, err error) {
+	iter := s.db.NewIterator(util.BytesPrefix(append(append([]byte{}, hashPrefix...), contract.Bytes()...)), nil)
+	defer iter.Release()
+	for valid := iter.Last(); valid; valid = iter.Prev() {
+		key := iter.Key()
+		number = binary.BigEndian.Uint64(key[len(key)-8:])
+		hash = common.BytesToHash(iter.Value())
+		match, err := canonical(number, hash)
+		if err != nil {
+			return 0, common.Hash{}, false, err
+		}
+		if match {
+			return number, hash, true, nil
+		}
+	}
+	return 0, common.Hash{}, false, iter.Error()
+}
+
+// Events 按链上顺序返回合约在区块 [from, to] 之间的事件。
+func (s *Store) Events(contract common.Address, from, to uint64) ([]Event, error) {
+	limit := eventKey(contract, to, math.MaxUint32, math.MaxUint32)
+	iter := s.db.NewIterator(&util.Range{Start: eventKey(contract, from, 0, 0), Limit: append(limit, 0)}, nil)
+	defer iter.Release()
+
+	var events []Event
+	for iter.Next() {
+		var ev Event
+		if err := json.Unmarshal(iter.Value(), &ev); err != nil {
+			return nil, fmt.Errorf("parse event %x: %w", iter.Key(), err)
+		}
+		events = append(events, ev)
+	}
+	return events, iter.Error()
+}
@@ -0,0 +1,111 @@
+// Package fakerpc 是测试用的 JSON-RPC 节点：每个方法的响应由测试决定，
+// 可以返回结果、JSON-RPC 错误或 HTTP 错误，并记录每个方法被调用的次数。
+package fakerpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Error 是 JSON-RPC 错误对象
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Response 是对一次调用的响应。Status 非 0 且不是 200 时只返回这个 HTTP 状态码；
+// 否则 Error 非 nil 时返回 JSON-RPC 错误，再否则返回 Result（nil 编码为 null）。
+type Response struct {
+	Status int
+	Result any
+	Error  *Error
+}
+
+// Handler 根据方法名和参数决定响应
+type Handler func(method string, params json.RawMessage) Response
+
+// Server 是一个 HTTP JSON-RPC 节点
+type Server struct {
+	URL string
+
+	mu      sync.Mutex
+	handler Handler
+	calls   map[string]int
+}
+
+// New 启动节点，测试结束时自动关闭。
+func New(t *testing.T, handler Handler) *Server {
+	t.Helper()
+	s := &Server{handler: handler, calls: make(map[string]int)}
+	srv := httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(srv.Close)
+	s.URL = srv.URL
+	return s
+}
+
+// Dial 返回连接到节点的客户端，测试结束时自动关闭。
+func (s *Server) Dial(t *testing.T) *ethclient.Client {
+	t.Helper()
+	client, err := ethclient.Dial(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return client
+}
+
+// SetHandler 替换之后调用使用的响应。
+func (s *Server) SetHandler(handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handler = handler
+}
+
+// Calls 返回方法 method 被调用的次数。
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+type request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.calls[req.Method]++
+	handler := s.handler
+	s.mu.Unlock()
+
+	resp := handler(req.Method, req.Params)
+	if resp.Status != 0 && resp.Status != http.StatusOK {
+		http.Error(w, http.StatusText(resp.Status), resp.Status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	out := response{JSONRPC: "2.0", ID: req.ID, Result: resp.Result, Error: resp.Error}
+	if resp.Error != nil {
+		out.Result = nil
+	}
+	json.NewEncoder(w).Encode(out)
+}
@@ -0,0 +1,161 @@
+// Package gentest 检查 contractgen 生成的绑定、build/ 中的编译产物和 contracts/ 下的源码三者一致。
+// 生成绑定的包在测试中对自己的每个合约调用 Check。
+package gentest
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+	"github.com/clc781032855/go_ethereum/solc"
+)
+
+// Contract 描述一个由 contractgen 生成的合约，路径相对于调用测试的包目录
+type Contract struct {
+	Name     string // 合约名，也是 build/ 中产物的文件名前缀
+	Source   string // Solidity 源文件
+	BuildDir string // 编译产物目录
+	Binding  string // 生成的绑定文件
+	Pkg      string // 绑定所在的包名
+	Meta     *bind.MetaData
+	Runtime  *bytecode.Runtime
+}
+
+// Check 对 c 运行全部检查，每项检查是一个子测试。
+func Check(t *testing.T, c Contract) {
+	t.Run("BindingMatchesArtifacts", func(t *testing.T) { CheckBinding(t, c) })
+	t.Run("ArtifactsMatchSource", func(t *testing.T) { CheckSource(t, c) })
+	t.Run("CompileMatchesArtifacts", func(t *testing.T) { CheckCompile(t, c) })
+}
+
+func (c Contract) readArtifact(t *testing.T, ext string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(c.BuildDir, c.Name+ext))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// CheckBinding 确认绑定中嵌入的 ABI 和字节码与 build/ 中的产物相同，
+// 并且用这些产物重新运行 abigen 的结果与提交的绑定文件完全相同。
+func CheckBinding(t *testing.T, c Contract) {
+	abiJSON, bin := c.readArtifact(t, ".abi"), c.readArtifact(t, ".bin")
+	if c.Meta.ABI != abiJSON {
+		t.Errorf("binding ABI differs from build/%s.abi", c.Name)
+	}
+	if c.Meta.Bin != "0x"+bin {
+		t.Errorf("binding bytecode differs from build/%s.bin", c.Name)
+	}
+	if hex.EncodeToString(c.Runtime.Code) != c.readArtifact(t, ".bin-runtime") {
+		t.Errorf("%sRuntime differs from build/%s.bin-runtime", c.Name, c.Name)
+	}
+
+	code, err := bind.Bind([]string{c.Name}, []string{abiJSON}, []string{bin}, nil, c.Pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := os.ReadFile(c.Binding)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != string(committed) {
+		t.Errorf("%s differs from abigen output for build/ artifacts", c.Binding)
+	}
+}
+
+// CheckSource 确认 build/ 中元数据记录的源码哈希和编译参数与当前源码和固定的编译器一致。
+func CheckSource(t *testing.T, c Contract) {
+	var metadata struct {
+		Compiler struct {
+			Version string `json:"version"`
+		} `json:"compiler"`
+		Settings struct {
+			EVMVersion string `json:"evmVersion"`
+			Optimizer  struct {
+				Enabled bool `json:"enabled"`
+				Runs    int  `json:"runs"`
+			} `json:"optimizer"`
+		} `json:"settings"`
+		Sources map[string]struct {
+			Keccak256 string `json:"keccak256"`
+		} `json:"sources"`
+	}
+	if err := json.Unmarshal([]byte(c.readArtifact(t, ".metadata.json")), &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata.Compiler.Version != solc.Version {
+		t.Errorf("compiled with solc %s; pinned version is %s", metadata.Compiler.Version, solc.Version)
+	}
+	if s := metadata.Settings; s.EVMVersion != solc.EVMVersion || !s.Optimizer.Enabled || s.Optimizer.Runs != solc.OptimizerRuns {
+		t.Errorf("compiled with evmVersion %s, optimizer %v/%d; pinned %s, true/%d",
+			s.EVMVersion, s.Optimizer.Enabled, s.Optimizer.Runs, solc.EVMVersion, solc.OptimizerRuns)
+	}
+
+	source, err := os.ReadFile(c.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Base(c.Source)
+	entry, ok := metadata.Sources[file]
+	if !ok {
+		t.Fatalf("metadata has no entry for %s", file)
+	}
+	if want := crypto.Keccak256Hash(source).Hex(); entry.Keccak256 != want {
+		t.Errorf("build/ was compiled from a different %s (keccak %s, current %s)", file, entry.Keccak256, want)
+	}
+}
+
+// CheckCompile 重新编译源码，结果必须与 build/ 中的产物逐字节相同，immutable 位置与生成的运行时字节码相同。
+// 需要 node 和已缓存的 soljson，缺少时跳过。
+func CheckCompile(t *testing.T, c Contract) {
+	if testing.Short() {
+		t.Skip("compiling is slow")
+	}
+	if _, err := exec.LookPath("node"); err != nil && os.Getenv(solc.EnvNode) == "" {
+		t.Skip("node not found")
+	}
+	ctx := context.Background()
+	soljson, err := solc.Locate(ctx, false)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Skipf("soljson not available: %v", err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := os.ReadFile(c.Source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contracts, err := solc.Compile(ctx, soljson, map[string]string{filepath.Base(c.Source): string(source)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(contracts) != 1 || contracts[0].Name != c.Name {
+		t.Fatalf("compiled %d contracts from %s; want %s only", len(contracts), c.Source, c.Name)
+	}
+	compiled := contracts[0]
+	for _, a := range []struct{ ext, got string }{
+		{".abi", string(compiled.ABI)},
+		{".bin", compiled.Bin},
+		{".bin-runtime", compiled.BinRuntime},
+		{".metadata.json", compiled.Metadata},
+	} {
+		if a.got != c.readArtifact(t, a.ext) {
+			t.Errorf("build/%s%s differs from compiler output", c.Name, a.ext)
+		}
+	}
+	if !reflect.DeepEqual(compiled.Immutables, c.Runtime.Immutables) {
+		t.Errorf("%sRuntime immutables %v differ from compiler output %v", c.Name, c.Runtime.Immutables, compiled.Immutables)
+	}
+}
@@ -0,0 +1,90 @@
+// Package simtest 为测试创建模拟链和有余额的账户。
+package simtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ChainID 是模拟链的链ID
+var ChainID = params.AllDevChainProtocolChanges.ChainID
+
+// Balance 是 NewBackend 给每个账户的初始余额：100 ETH
+var Balance = new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))
+
+// postMerge 把创世区块的难度设为 0。否则针对创世区块的调用和估算不会启用 Shanghai，
+// 部署含 PUSH0 的字节码会失败。
+func postMerge(_ *node.Config, conf *ethconfig.Config) {
+	conf.Genesis.Difficulty = new(big.Int)
+}
+
+// NewBackend 创建模拟链，funded 中的每个账户有 Balance，alloc 中的账户原样写入创世区块（可以为 nil）。
+// 测试结束时自动关闭。
+func NewBackend(t testing.TB, alloc types.GenesisAlloc, funded ...common.Address) *simulated.Backend {
+	t.Helper()
+	genesis := types.GenesisAlloc{}
+	for address, account := range alloc {
+		genesis[address] = account
+	}
+	for _, address := range funded {
+		genesis[address] = types.Account{Balance: new(big.Int).Set(Balance)}
+	}
+	sim := simulated.NewBackend(genesis, postMerge)
+	t.Cleanup(func() { sim.Close() })
+	return sim
+}
+
+// NewKey 生成一个新私钥。
+func NewKey(t testing.TB) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// NewAccount 生成一个新账户，返回它在模拟链上的 TransactOpts。
+func NewAccount(t testing.TB) *bind.TransactOpts {
+	t.Helper()
+	auth, err := bind.NewKeyedTransactorWithChainID(NewKey(t), ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return auth
+}
+
+// Mine 出块并确认 tx 已打包且执行成功，返回回执。
+func Mine(t testing.TB, sim *simulated.Backend, tx *types.Transaction) *types.Receipt {
+	t.Helper()
+	sim.Commit()
+	receipt, err := bind.WaitMined(context.Background(), sim.Client(), tx)
+	if err != nil {
+		t.Fatalf("wait mined: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("tx %s failed", tx.Hash().Hex())
+	}
+	return receipt
+}
+
+// Transact 以 auth 的身份调用 fn 发送交易，出块并确认执行成功。
+func Transact(t testing.TB, sim *simulated.Backend, auth *bind.TransactOpts, fn func(*bind.TransactOpts) (*types.Transaction, error)) *types.Receipt {
+	t.Helper()
+	tx, err := fn(auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Mine(t, sim, tx)
+}
@@ -0,0 +1,174 @@
+package nonce
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Source 提供账户的 pending nonce，一般就是 ethclient.Client。
+type Source interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+type accountKey struct {
+	chainID string
+	address common.Address
+}
+
+// account 记录一个 (链, 地址) 的 nonce 分配状态。
+type account struct {
+	mu       sync.Mutex
+	synced   bool
+	next     uint64              // 下一个从未分配过的 nonce
+	released []uint64            // 被放弃、可以重新分配的 nonce（升序），优先分配以填补空洞
+	inflight map[uint64]struct{} // 已分配但还没有 Commit / Abandon 的 nonce
+}
+
+// Manager 按 (链ID, 地址) 分配 nonce，可以被多个 goroutine 共享。
+type Manager struct {
+	mu       sync.Mutex
+	accounts map[accountKey]*account
+}
+
+// NewManager 创建 nonce 管理器。
+func NewManager() *Manager {
+	return &Manager{accounts: make(map[accountKey]*account)}
+}
+
+func (m *Manager) account(chainID *big.Int, address common.Address) *account {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := accountKey{chainID: chainID.String(), address: address}
+	acc, ok := m.accounts[key]
+	if !ok {
+		acc = &account{inflight: make(map[uint64]struct{})}
+		m.accounts[key] = acc
+	}
+	return acc
+}
+
+// Reservation 是一个已分配的 nonce。发送成功后调用 Commit，
+// 交易没有被节点接受时调用 Abandon，否则这个 nonce 会一直被占用。
+type Reservation struct {
+	Nonce uint64
+
+	acc  *account
+	done bool
+}
+
+// Reserve 为账户分配下一个 nonce。首次使用或出现 nonce 错误后，会先用 PendingNonceAt 同步。
+func (m *Manager) Reserve(ctx context.Context, src Source, chainID *big.Int, address common.Address) (*Reservation, error) {
+	acc := m.account(chainID, address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+
+	if !acc.synced {
+		if err := acc.resync(ctx, src, address); err != nil {
+			return nil, err
+		}
+	}
+	var n uint64
+	if len(acc.released) > 0 {
+		n, acc.released = acc.released[0], acc.released[1:]
+	} else {
+		n = acc.next
+		acc.next++
+	}
+	acc.inflight[n] = struct{}{}
+	return &Reservation{Nonce: n, acc: acc}, nil
+}
+
+// Resync 立即用节点的 pending nonce 重新同步账户状态。
+func (m *Manager) Resync(ctx context.Context, src Source, chainID *big.Int, address common.Address) error {
+	acc := m.account(chainID, address)
+	acc.mu.Lock()
+	defer acc.mu.Unlock()
+	return acc.resync(ctx, src, address)
+}
+
+// resync 以节点为准：pending 之前的 nonce 都已被使用；
+// [pending, next) 之间既不在途、也不在节点交易池里的 nonce 说明交易被丢弃了，放回待分配列表。
+func (acc *account) resync(ctx context.Context, src Source, address common.Address) error {
+	pending, err := src.PendingNonceAt(ctx, address)
+	if err != nil {
+		return fmt.Errorf("get pending nonce of %s: %w", address.Hex(), err)
+	}
+	acc.released = acc.released[:0]
+	if pending >= acc.next {
+		acc.next = pending
+	} else {
+		for n := pending; n < acc.next; n++ {
+			if _, ok := acc.inflight[n]; !ok {
+				acc.released = append(acc.released, n)
+			}
+		}
+	}
+	acc.synced = true
+	return nil
+}
+
+// Commit 表示交易已被节点接受。
+func (r *Reservation) Commit() {
+	r.acc.mu.Lock()
+	defer r.acc.mu.Unlock()
+	if r.done {
+		return
+	}
+	r.done = true
+	delete(r.acc.inflight, r.Nonce)
+}
+
+// Abandon 表示交易没有发出去。err 是 nonce 相关错误时（例如 "nonce too low"），
+// 说明本地状态已经过期，下次分配前会重新同步；否则把这个 nonce 放回去，由下一次分配填补。
+func (r *Reservation) Abandon(err error) {
+	r.acc.mu.Lock()
+	defer r.acc.mu.Unlock()
+	if r.done {
+		return
+	}
+	r.done = true
+	delete(r.acc.inflight, r.Nonce)
+
+	if IsNonceError(err) {
+		r.acc.synced = false
+		return
+	}
+	i := sort.Search(len(r.acc.released), func(i int) bool { return r.acc.released[i] >= r.Nonce })
+	r.acc.released = append(r.acc.released, 0)
+	copy(r.acc.released[i+1:], r.acc.released[i:])
+	r.acc.released[i] = r.Nonce
+}
+
+// BigInt 返回 *big.Int 形式的 nonce，方便填入 bind.TransactOpts.Nonce。
+func (r *Reservation) BigInt() *big.Int {
+	return new(big.Int).SetUint64(r.Nonce)
+}
+
+// nonceErrors 是节点返回的、说明本地 nonce 已经与链上不一致的错误信息。
+var nonceErrors = []string{
+	"nonce too low",
+	"nonce too high",
+	"invalid nonce",
+	"replacement transaction underpriced",
+}
+
+// IsNonceError 判断发送交易的错误是否由 nonce 不一致导致。
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range nonceErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
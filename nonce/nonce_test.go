@@ -0,0 +1,174 @@
+package nonce
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	testChain   = big.NewInt(1337)
+	testAccount = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+)
+
+// fakeSource 返回可以随时修改的 pending nonce，并记录查询次数
+type fakeSource struct {
+	mu      sync.Mutex
+	pending uint64
+	calls   int
+}
+
+func (s *fakeSource) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.pending, nil
+}
+
+func (s *fakeSource) set(pending uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = pending
+}
+
+func (s *fakeSource) callCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func reserve(t *testing.T, m *Manager, src Source) *Reservation {
+	t.Helper()
+	r, err := m.Reserve(context.Background(), src, testChain, testAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestReserveConcurrent(t *testing.T) {
+	const workers = 50
+	src := &fakeSource{pending: 7}
+	m := NewManager()
+
+	nonces := make([]uint64, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r, err := m.Reserve(context.Background(), src, testChain, testAccount)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			nonces[i] = r.Nonce
+			r.Commit()
+		}(i)
+	}
+	wg.Wait()
+
+	// 互不重复且连续：排序后正好是 7, 8, ..., 7+workers-1
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	for i, n := range nonces {
+		if n != 7+uint64(i) {
+			t.Fatalf("sorted nonces = %v; want %d..%d", nonces, 7, 7+workers-1)
+		}
+	}
+	if calls := src.callCount(); calls != 1 {
+		t.Errorf("PendingNonceAt called %d times; want 1", calls)
+	}
+}
+
+func TestAbandonFillsGap(t *testing.T) {
+	src := &fakeSource{pending: 0}
+	m := NewManager()
+
+	r0, r1, r2 := reserve(t, m, src), reserve(t, m, src), reserve(t, m, src)
+	r0.Commit()
+	r2.Commit()
+	r1.Abandon(errors.New("insufficient funds for gas * price + value"))
+
+	if r := reserve(t, m, src); r.Nonce != 1 {
+		t.Errorf("after abandoning 1, Reserve = %d; want 1", r.Nonce)
+	}
+	if r := reserve(t, m, src); r.Nonce != 3 {
+		t.Errorf("after the gap is filled, Reserve = %d; want 3", r.Nonce)
+	}
+
+	// 重复调用 Commit / Abandon 没有影响
+	r1.Abandon(errors.New("again"))
+	r2.Abandon(errors.New("after commit"))
+	if r := reserve(t, m, src); r.Nonce != 4 {
+		t.Errorf("Reserve after repeated Abandon = %d; want 4", r.Nonce)
+	}
+}
+
+func TestNonceErrorForcesResync(t *testing.T) {
+	src := &fakeSource{pending: 3}
+	m := NewManager()
+
+	r := reserve(t, m, src)
+	if r.Nonce != 3 {
+		t.Fatalf("first Reserve = %d; want 3", r.Nonce)
+	}
+	// 同一账户在其他地方发出了交易，节点的 pending nonce 已经前进
+	src.set(10)
+	r.Abandon(errors.New("nonce too low: next nonce 10, tx nonce 3"))
+
+	if r := reserve(t, m, src); r.Nonce != 10 {
+		t.Errorf("Reserve after nonce error = %d; want 10", r.Nonce)
+	}
+	if calls := src.callCount(); calls != 2 {
+		t.Errorf("PendingNonceAt called %d times; want 2", calls)
+	}
+}
+
+func TestResyncKeepsInflight(t *testing.T) {
+	src := &fakeSource{pending: 0}
+	m := NewManager()
+
+	var rs []*Reservation
+	for i := 0; i < 5; i++ {
+		rs = append(rs, reserve(t, m, src))
+	}
+	// 0、1 已上链，2 和 4 在途，3 发出后被节点丢弃
+	rs[0].Commit()
+	rs[1].Commit()
+	rs[3].Commit()
+	src.set(2)
+	if err := m.Resync(context.Background(), src, testChain, testAccount); err != nil {
+		t.Fatal(err)
+	}
+
+	// pending < next：只有不在途的 3 被重新分配，在途的 2 和 4 不会重复
+	if r := reserve(t, m, src); r.Nonce != 3 {
+		t.Errorf("Reserve after resync = %d; want 3", r.Nonce)
+	}
+	if r := reserve(t, m, src); r.Nonce != 5 {
+		t.Errorf("second Reserve after resync = %d; want 5", r.Nonce)
+	}
+}
+
+func TestIsNonceError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("nonce too low"), true},
+		{errors.New("Nonce Too High"), true},
+		{errors.New("replacement transaction underpriced"), true},
+		{errors.New("insufficient funds"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := IsNonceError(tt.err); got != tt.want {
+			t.Errorf("IsNonceError(%v) = %v; want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Package output 把命令结果输出为人类可读文本或 JSON / NDJSON / CSV。
+//
+// 机器可读格式下，进度提示写到 stderr，stdout 只包含结构化结果，
+// 字段名取自记录结构体的 json 标签，保持稳定以便脚本解析。
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format 是输出格式
+type Format string
+
+const (
+	Human  Format = "human"  // 带表情符号的文本（默认）
+	JSON   Format = "json"   // 单个 JSON 文档，列表输出为数组
+	NDJSON Format = "ndjson" // 每行一个 JSON 对象
+	CSV    Format = "csv"    // 表头加数据行
+)
+
+// Formats 是支持的格式名称
+var Formats = []Format{Human, JSON, NDJSON, CSV}
+
+// ParseFormat 解析格式名称，空字符串表示 Human。
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case "", Human:
+		return Human, nil
+	case JSON, NDJSON, CSV:
+		return f, nil
+	}
+	return "", fmt.Errorf("unknown output format %q (want human, json, ndjson or csv)", s)
+}
+
+// Writer 按格式输出进度和结果。
+type Writer struct {
+	format Format
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// New 创建 Writer，进度提示在 Human 模式下写到 stdout，其他模式写到 stderr。
+func New(format Format, stdout, stderr io.Writer) *Writer {
+	return &Writer{format: format, stdout: stdout, stderr: stderr}
+}
+
+// Format 返回输出格式。
+func (w *Writer) Format() Format { return w.format }
+
+// Human 报告是否使用人类可读格式。
+func (w *Writer) Human() bool { return w.format == Human }
+
+// progress 返回进度提示的目标。
+func (w *Writer) progress() io.Writer {
+	if w.Human() {
+		return w.stdout
+	}
+	return w.stderr
+}
+
+// Printf 输出进度提示。
+func (w *Writer) Printf(format string, args ...any) {
+	fmt.Fprintf(w.progress(), format, args...)
+}
+
+// Println 输出一行进度提示。
+func (w *Writer) Println(args ...any) {
+	fmt.Fprintln(w.progress(), args...)
+}
+
+// Stdout 返回结果输出目标，供 Human 模式下的表格等使用。
+func (w *Writer) Stdout() io.Writer { return w.stdout }
+
+// Emit 输出命令结果：Human 模式调用 human 打印文本，其他模式编码 v。
+// v 是结构体（或其指针）时输出一条记录，是切片时输出多条记录。
+func (w *Writer) Emit(v any, human func()) error {
+	switch w.format {
+	case JSON:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.IsNil() {
+			v = []any{} // 空列表输出 [] 而不是 null
+		}
+		enc := json.NewEncoder(w.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case NDJSON:
+		enc := json.NewEncoder(w.stdout)
+		for _, rec := range records(v) {
+			if err := enc.Encode(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	case CSV:
+		return writeCSV(w.stdout, v)
+	default:
+		if human != nil {
+			human()
+		}
+		return nil
+	}
+}
+
+// records 把切片展开为记录列表，其他值作为单条记录。
+func records(v any) []any {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+	recs := make([]any, rv.Len())
+	for i := range recs {
+		recs[i] = rv.Index(i).Interface()
+	}
+	return recs
+}
+
+// writeCSV 输出表头和数据行，列名和顺序取自记录类型的 json 标签，
+// 因此没有记录时也会输出表头。
+func writeCSV(w io.Writer, v any) error {
+	cw := csv.NewWriter(w)
+	fields := columns(recordType(v))
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, rec := range records(v) {
+		rv := reflect.Indirect(reflect.ValueOf(rec))
+		row := make([]string, len(fields))
+		for i, f := range fields {
+			if f.index == nil {
+				row[i] = cell(rv)
+			} else if rv.IsValid() {
+				row[i] = cell(rv.FieldByIndex(f.index))
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// recordType 返回单条记录的类型（切片取元素类型，指针取指向的类型）。
+func recordType(v any) reflect.Type {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// column 是 CSV 的一列
+type column struct {
+	name  string
+	index []int // nil 表示记录本身不是结构体，整条记录作为一列
+}
+
+// columns 列出结构体的导出字段，嵌套结构和切片在单元格中编码为 JSON。
+func columns(t reflect.Type) []column {
+	if t == nil || t.Kind() != reflect.Struct {
+		return []column{{name: "value"}}
+	}
+	var cols []column
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		cols = append(cols, column{name: name, index: field.Index})
+	}
+	return cols
+}
+
+// cell 把单个字段转换为 CSV 单元格，nil 输出为空。
+func cell(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return ""
+		}
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	v = reflect.Indirect(v)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprint(v.Interface())
+	}
+	return string(data)
+}
+
+// BigInt 以十进制字符串编码大整数，避免 JSON 解析端把 Wei 金额转换为浮点数丢失精度。
+type BigInt big.Int
+
+// NewBigInt 包装 x，x 为 nil 时返回 nil。
+func NewBigInt(x *big.Int) *BigInt {
+	return (*BigInt)(x)
+}
+
+// Big 返回底层的 *big.Int。
+func (b *BigInt) Big() *big.Int { return (*big.Int)(b) }
+
+// String 返回十进制表示。
+func (b *BigInt) String() string { return b.Big().String() }
+
+// MarshalJSON 编码为十进制字符串。
+func (b *BigInt) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, b.String()), nil
+}
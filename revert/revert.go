@@ -0,0 +1,172 @@
+package revert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrExecutionReverted 是所有解码后回滚错误的公共哨兵，可以用 errors.Is 判断。
+var ErrExecutionReverted = errors.New("execution reverted")
+
+// Kind 是回滚数据的类型。
+type Kind string
+
+const (
+	KindError   Kind = "Error"   // require / revert("...") 产生的 Error(string)
+	KindPanic   Kind = "Panic"   // assert、溢出、除零等产生的 Panic(uint256)
+	KindCustom  Kind = "Custom"  // 合约 ABI 中声明的自定义错误
+	KindUnknown Kind = "Unknown" // 无回滚数据或无法识别
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// Error 是解码后的回滚原因。
+type Error struct {
+	Kind   Kind
+	Reason string        // Error(string) 的消息、Panic 的说明或自定义错误的签名
+	Code   *big.Int      // Panic 错误码
+	Name   string        // 自定义错误名称
+	Args   []interface{} // 自定义错误参数
+	Data   []byte        // 原始回滚数据
+	TxHash common.Hash   // 通过 Replay 得到时为失败交易的哈希
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(ErrExecutionReverted.Error())
+	switch e.Kind {
+	case KindError:
+		fmt.Fprintf(&b, ": %s", e.Reason)
+	case KindPanic:
+		fmt.Fprintf(&b, ": panic %#x (%s)", e.Code, e.Reason)
+	case KindCustom:
+		fmt.Fprintf(&b, ": %s%v", e.Name, e.Args)
+	default:
+		if e.Reason != "" {
+			fmt.Fprintf(&b, ": %s", e.Reason)
+		} else if len(e.Data) > 0 {
+			fmt.Fprintf(&b, ": unrecognized data %s", hexutil.Encode(e.Data))
+		}
+	}
+	if e.TxHash != (common.Hash{}) {
+		fmt.Fprintf(&b, " (tx %s)", e.TxHash.Hex())
+	}
+	return b.String()
+}
+
+func (e *Error) Unwrap() error { return ErrExecutionReverted }
+
+// Unpack 解码回滚数据。contractABI 用于识别自定义错误，可以为 nil。
+func Unpack(data []byte, contractABI *abi.ABI) *Error {
+	e := &Error{Kind: KindUnknown, Data: common.CopyBytes(data)}
+	if len(data) < 4 {
+		return e
+	}
+	selector := data[:4]
+	switch {
+	case bytes.Equal(selector, errorSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			e.Kind, e.Reason = KindError, reason
+		}
+	case bytes.Equal(selector, panicSelector):
+		if len(data) == 4+32 {
+			reason, _ := abi.UnpackRevert(data)
+			e.Kind, e.Code, e.Reason = KindPanic, new(big.Int).SetBytes(data[4:]), reason
+		}
+	case contractABI != nil:
+		for name, abiErr := range contractABI.Errors {
+			if !bytes.Equal(abiErr.ID[:4], selector) {
+				continue
+			}
+			args, err := abiErr.Inputs.Unpack(data[4:])
+			if err != nil {
+				break
+			}
+			e.Kind, e.Name, e.Args, e.Reason = KindCustom, name, args, abiErr.Sig
+			break
+		}
+	}
+	return e
+}
+
+// FromError 从 eth_call / eth_estimateGas 返回的 RPC 错误中提取回滚数据并解码。
+// 错误中不带回滚数据时返回 false。
+func FromError(err error, contractABI *abi.ABI) (*Error, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	var data []byte
+	switch v := dataErr.ErrorData().(type) {
+	case string:
+		decoded, decodeErr := hexutil.Decode(v)
+		if decodeErr != nil {
+			return nil, false
+		}
+		data = decoded
+	case []byte:
+		data = v
+	default:
+		return nil, false
+	}
+	return Unpack(data, contractABI), true
+}
+
+// Replay 在失败交易所在的区块上用 CallContract 重放交易，解码回滚原因。
+// 重放使用该区块执行后的状态，同一区块中后续交易改变了相关状态时结果可能与实际不同。
+func Replay(ctx context.Context, backend ethereum.ContractCaller, tx *types.Transaction, receipt *types.Receipt, contractABI *abi.ABI) (*Error, error) {
+	from, err := sender(tx)
+	if err != nil {
+		return nil, err
+	}
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	_, callErr := backend.CallContract(ctx, msg, receipt.BlockNumber)
+	if callErr == nil {
+		// 重放没有回滚：最常见的原因是 gas 不足
+		e := &Error{Kind: KindUnknown, TxHash: tx.Hash(), Reason: "replay did not revert"}
+		if receipt.GasUsed >= tx.Gas() {
+			e.Reason = "out of gas"
+		}
+		return e, nil
+	}
+	e, ok := FromError(callErr, contractABI)
+	if !ok {
+		e = &Error{Kind: KindUnknown, Reason: callErr.Error()}
+	}
+	e.TxHash = tx.Hash()
+	return e, nil
+}
+
+func sender(tx *types.Transaction) (common.Address, error) {
+	var signer types.Signer = types.HomesteadSigner{}
+	if chainID := tx.ChainId(); chainID != nil && chainID.Sign() > 0 {
+		signer = types.LatestSignerForChainID(chainID)
+	}
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recover sender: %w", err)
+	}
+	return from, nil
+}
@@ -0,0 +1,160 @@
+package revert
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const testABI = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"}]}
+]`
+
+func parseABI(t *testing.T) *abi.ABI {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(testABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &parsed
+}
+
+// encode 按 ABI 编码 selector 后面的参数
+func encode(t *testing.T, selector []byte, types []string, args ...interface{}) []byte {
+	t.Helper()
+	var arguments abi.Arguments
+	for _, name := range types {
+		typ, err := abi.NewType(name, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		arguments = append(arguments, abi.Argument{Type: typ})
+	}
+	packed, err := arguments.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(append([]byte{}, selector...), packed...)
+}
+
+func TestUnpack(t *testing.T) {
+	contractABI := parseABI(t)
+	caller := common.HexToAddress("0x00000000000000000000000000000000000BAD00")
+	insufficient := contractABI.Errors["InsufficientBalance"].ID.Bytes()[:4]
+	unauthorized := contractABI.Errors["Unauthorized"].ID.Bytes()[:4]
+	errorData := encode(t, errorSelector, []string{"string"}, "Count cannot be negative")
+
+	for _, tc := range []struct {
+		name string
+		data []byte
+		abi  *abi.ABI
+		want Error // Data 总是等于输入，不必填写
+	}{
+		{"error string", errorData, nil, Error{Kind: KindError, Reason: "Count cannot be negative"}},
+		{"empty error string", encode(t, errorSelector, []string{"string"}, ""), nil, Error{Kind: KindError}},
+		{"custom error", encode(t, insufficient, []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(2)), contractABI,
+			Error{Kind: KindCustom, Name: "InsufficientBalance", Reason: "InsufficientBalance(uint256,uint256)", Args: []interface{}{big.NewInt(1), big.NewInt(2)}}},
+		{"custom error with address", encode(t, unauthorized, []string{"address"}, caller), contractABI,
+			Error{Kind: KindCustom, Name: "Unauthorized", Reason: "Unauthorized(address)", Args: []interface{}{caller}}},
+		{"custom error without ABI", encode(t, unauthorized, []string{"address"}, caller), nil, Error{Kind: KindUnknown}},
+		{"unknown selector", []byte{0xde, 0xad, 0xbe, 0xef, 0, 0}, contractABI, Error{Kind: KindUnknown}},
+		{"no data", nil, contractABI, Error{Kind: KindUnknown}},
+		{"shorter than selector", []byte{0x08, 0xc3}, nil, Error{Kind: KindUnknown}},
+		{"truncated error string", errorData[:4+32+32+10], nil, Error{Kind: KindUnknown}},
+		{"truncated panic", encode(t, panicSelector, []string{"uint256"}, big.NewInt(1))[:20], nil, Error{Kind: KindUnknown}},
+		{"truncated custom error", encode(t, insufficient, []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(2))[:40], contractABI, Error{Kind: KindUnknown}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Unpack(tc.data, tc.abi)
+			want := tc.want
+			want.Data = common.CopyBytes(tc.data)
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("Unpack = %+v; want %+v", *got, want)
+			}
+			if !errors.Is(got, ErrExecutionReverted) {
+				t.Errorf("%v does not match ErrExecutionReverted", got)
+			}
+		})
+	}
+}
+
+func TestUnpackPanic(t *testing.T) {
+	for code, reason := range map[int64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+		0x99: "unknown panic code: 0x99",
+	} {
+		got := Unpack(encode(t, panicSelector, []string{"uint256"}, big.NewInt(code)), nil)
+		if got.Kind != KindPanic || got.Code.Int64() != code || got.Reason != reason {
+			t.Errorf("panic %#x: got %s %v %q; want Panic %q", code, got.Kind, got.Code, got.Reason, reason)
+		}
+		if want := fmt.Sprintf("execution reverted: panic %#x (%s)", code, reason); got.Error() != want {
+			t.Errorf("panic %#x: message %q; want %q", code, got.Error(), want)
+		}
+	}
+}
+
+// dataError 模拟带回滚数据的 JSON-RPC 错误（rpc.DataError）
+type dataError struct {
+	data interface{}
+}
+
+func (e *dataError) Error() string          { return "execution reverted" }
+func (e *dataError) ErrorData() interface{} { return e.data }
+
+func TestFromError(t *testing.T) {
+	contractABI := parseABI(t)
+	errorData := encode(t, errorSelector, []string{"string"}, "Only owner")
+	custom := encode(t, contractABI.Errors["InsufficientBalance"].ID.Bytes()[:4], []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(2))
+
+	for _, tc := range []struct {
+		name   string
+		err    error
+		ok     bool
+		kind   Kind
+		reason string
+	}{
+		{"hex string", &dataError{hexutil.Encode(errorData)}, true, KindError, "Only owner"},
+		{"bytes", &dataError{errorData}, true, KindError, "Only owner"},
+		{"wrapped", fmt.Errorf("call counter: %w", &dataError{hexutil.Encode(errorData)}), true, KindError, "Only owner"},
+		{"custom error", &dataError{hexutil.Encode(custom)}, true, KindCustom, "InsufficientBalance(uint256,uint256)"},
+		{"panic", &dataError{hexutil.Encode(encode(t, panicSelector, []string{"uint256"}, big.NewInt(0x11)))}, true, KindPanic, "arithmetic underflow or overflow"},
+		{"revert without reason", &dataError{"0x"}, true, KindUnknown, ""},
+		{"invalid hex", &dataError{"not hex"}, false, "", ""},
+		{"unexpected data type", &dataError{map[string]any{"data": "0x"}}, false, "", ""},
+		{"no data", &dataError{nil}, false, "", ""},
+		{"not a data error", errors.New("execution reverted"), false, "", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := FromError(tc.err, contractABI)
+			if ok != tc.ok {
+				t.Fatalf("ok = %v; want %v (%+v)", ok, tc.ok, got)
+			}
+			if !ok {
+				if got != nil {
+					t.Errorf("got %+v without revert data", got)
+				}
+				return
+			}
+			if got.Kind != tc.kind || got.Reason != tc.reason {
+				t.Errorf("got %s %q; want %s %q", got.Kind, got.Reason, tc.kind, tc.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// NewKeystoreSigner 解密 go-ethereum keystore JSON 文件（geth account new / clef 生成的格式）。
+func NewKeystoreSigner(path, password string) (*KeySigner, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keystore: %w", err)
+	}
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore %s: %w", path, err)
+	}
+	return NewKeySigner(key.PrivateKey), nil
+}
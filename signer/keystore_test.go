@@ -0,0 +1,43 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestKeystoreSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewKeystoreSigner(account.URL.Path, "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Address() != account.Address {
+		t.Fatalf("address = %s; want %s", s.Address().Hex(), account.Address.Hex())
+	}
+	signed, err := s.SignTx(context.Background(), unsignedTx(), testChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+	if err != nil || sender != account.Address {
+		t.Errorf("sender = %s, %v; want %s", sender.Hex(), err, account.Address.Hex())
+	}
+
+	if _, err := NewKeystoreSigner(account.URL.Path, "wrong"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Errorf("wrong passphrase: err = %v; want %v", err, keystore.ErrDecrypt)
+	}
+}
@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultHDPath 是以太坊钱包通用的第一个账户路径 (BIP-44)。
+const DefaultHDPath = "m/44'/60'/0'/0/0"
+
+// NewMnemonicSigner 用 BIP-39 助记词和 BIP-44 路径派生私钥。path 为空时使用 DefaultHDPath。
+func NewMnemonicSigner(mnemonic, passphrase, path string) (*KeySigner, error) {
+	key, err := DeriveKey(mnemonic, passphrase, path)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySigner(key), nil
+}
+
+// DeriveKey 用 BIP-39 助记词和 BIP-44 路径派生私钥。path 为空时使用 DefaultHDPath。
+func DeriveKey(mnemonic, passphrase, path string) (*ecdsa.PrivateKey, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid BIP-39 mnemonic")
+	}
+	if path == "" {
+		path = DefaultHDPath
+	}
+	derivation, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("parse derivation path: %w", err)
+	}
+	seed := bip39.NewSeed(mnemonic, passphrase)
+	return deriveKey(seed, derivation)
+}
+
+// deriveKey 按 BIP-32 从种子派生私钥。
+func deriveKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	curveN := crypto.S256().Params().N
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+	if key.Sign() == 0 || key.Cmp(curveN) >= 0 {
+		return nil, errors.New("invalid master key derived from seed")
+	}
+
+	for _, index := range path {
+		var data []byte
+		if index >= 0x80000000 {
+			// 硬化派生：0x00 || 私钥 || index
+			data = append([]byte{0}, crypto.FromECDSA(toECDSA(key))...)
+		} else {
+			// 普通派生：压缩公钥 || index
+			data = crypto.CompressPubkey(&toECDSA(key).PublicKey)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(curveN) >= 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		key = tweak.Add(tweak, key).Mod(tweak, curveN)
+		if key.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		chainCode = sum[32:]
+	}
+	return toECDSA(key), nil
+}
+
+func toECDSA(d *big.Int) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(d.FillBytes(make([]byte, 32)))
+	if err != nil {
+		// d 已经保证在 (0, N) 区间内
+		panic(err)
+	}
+	return key
+}
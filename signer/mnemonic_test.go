@@ -0,0 +1,63 @@
+package signer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BIP-32 测试向量 1：https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vector-1
+func TestDeriveKeyBIP32Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	for _, tc := range []struct {
+		path accounts.DerivationPath
+		key  string
+	}{
+		{accounts.DerivationPath{}, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{accounts.DerivationPath{0x80000000}, "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{accounts.DerivationPath{0x80000000, 1}, "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{accounts.DerivationPath{0x80000000, 1, 0x80000002}, "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{accounts.DerivationPath{0x80000000, 1, 0x80000002, 2}, "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{accounts.DerivationPath{0x80000000, 1, 0x80000002, 2, 1000000000}, "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	} {
+		key, err := deriveKey(seed, tc.path)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.path, err)
+		}
+		if got := hex.EncodeToString(crypto.FromECDSA(key)); got != tc.key {
+			t.Errorf("%s: key = %s; want %s", tc.path, got, tc.key)
+		}
+	}
+}
+
+// Hardhat / Anvil 默认助记词派生的前两个账户
+func TestDeriveKeyBIP44(t *testing.T) {
+	const mnemonic = "test test test test test test test test test test test junk"
+	for _, tc := range []struct {
+		path, key, address string
+	}{
+		{"", "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"},
+		{"m/44'/60'/0'/0/1", "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"},
+	} {
+		s, err := NewMnemonicSigner(mnemonic, "", tc.path)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.path, err)
+		}
+		if s.Address().Hex() != tc.address {
+			t.Errorf("%q: address = %s; want %s", tc.path, s.Address().Hex(), tc.address)
+		}
+		key, err := DeriveKey("  "+mnemonic+"\n", "", tc.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(crypto.FromECDSA(key)); got != tc.key {
+			t.Errorf("%q: key = %s; want %s", tc.path, got, tc.key)
+		}
+	}
+
+	if _, err := DeriveKey("test test test test test test test test test test test test", "", ""); err == nil {
+		t.Error("accepted a mnemonic with a bad checksum")
+	}
+}
@@ -0,0 +1,146 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// 远程签名方法
+const (
+	MethodClef = "account_signTransaction" // Clef 外部签名器
+	MethodEth  = "eth_signTransaction"     // 节点内置账户
+)
+
+// RemoteSigner 通过 JSON-RPC 请求外部签名器签名，私钥不离开签名器。
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+	method  string
+}
+
+// DialRemote 连接远程签名器。address 为零值时取签名器返回的第一个账户。
+func DialRemote(ctx context.Context, url string, address common.Address, method string) (*RemoteSigner, error) {
+	if method == "" {
+		method = MethodClef
+	}
+	if method != MethodClef && method != MethodEth {
+		return nil, fmt.Errorf("unsupported remote signing method %q", method)
+	}
+	client, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("dial remote signer: %w", err)
+	}
+	if address == (common.Address{}) {
+		listMethod := "account_list"
+		if method == MethodEth {
+			listMethod = "eth_accounts"
+		}
+		var accounts []common.Address
+		if err := client.CallContext(ctx, &accounts, listMethod); err != nil {
+			client.Close()
+			return nil, fmt.Errorf("%s: %w", listMethod, err)
+		}
+		if len(accounts) == 0 {
+			client.Close()
+			return nil, errors.New("remote signer has no accounts")
+		}
+		address = accounts[0]
+	}
+	return &RemoteSigner{client: client, address: address, method: method}, nil
+}
+
+// Close 关闭与签名器的连接。
+func (s *RemoteSigner) Close() { s.client.Close() }
+
+// Address 实现 Signer。
+func (s *RemoteSigner) Address() common.Address { return s.address }
+
+// sendTxArgs 是 account_signTransaction / eth_signTransaction 的参数格式。
+type sendTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+// signTxResult 是两种方法共同的返回格式。
+type signTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignTx 实现 Signer。签名结果会与请求逐项比对，防止签名器篡改交易内容。
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args := sendTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("remote signer: unsupported transaction type %d", tx.Type())
+	}
+
+	var res signTxResult
+	if err := s.client.CallContext(ctx, &res, s.method, args); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.method, err)
+	}
+	signed := new(types.Transaction)
+	if err := signed.UnmarshalBinary(res.Raw); err != nil {
+		return nil, fmt.Errorf("decode signed transaction: %w", err)
+	}
+	if err := checkSigned(tx, signed, chainID, s.address); err != nil {
+		return nil, fmt.Errorf("remote signer returned unexpected transaction: %w", err)
+	}
+	return signed, nil
+}
+
+// checkSigned 校验签名器返回的交易与请求一致，且确实由 from 签名。
+func checkSigned(want, got *types.Transaction, chainID *big.Int, from common.Address) error {
+	switch {
+	case got.Type() != want.Type():
+		return fmt.Errorf("type %d, want %d", got.Type(), want.Type())
+	case got.Nonce() != want.Nonce():
+		return fmt.Errorf("nonce %d, want %d", got.Nonce(), want.Nonce())
+	case got.Gas() != want.Gas():
+		return fmt.Errorf("gas %d, want %d", got.Gas(), want.Gas())
+	case got.Value().Cmp(want.Value()) != 0:
+		return fmt.Errorf("value %s, want %s", got.Value(), want.Value())
+	case got.GasFeeCap().Cmp(want.GasFeeCap()) != 0 || got.GasTipCap().Cmp(want.GasTipCap()) != 0:
+		return errors.New("fee fields differ")
+	case !bytes.Equal(got.Data(), want.Data()):
+		return errors.New("data differs")
+	case (got.To() == nil) != (want.To() == nil) || (got.To() != nil && *got.To() != *want.To()):
+		return errors.New("recipient differs")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), got)
+	if err != nil {
+		return fmt.Errorf("recover sender: %w", err)
+	}
+	if sender != from {
+		return fmt.Errorf("signed by %s, want %s", sender.Hex(), from.Hex())
+	}
+	return nil
+}
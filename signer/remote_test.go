@@ -0,0 +1,158 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/clc781032855/go_ethereum/internal/fakerpc"
+)
+
+var testChainID = big.NewInt(1337)
+
+// forgery 描述假签名器对交易的篡改，零值表示如实签名
+type forgery struct {
+	tamper   func(*types.DynamicFeeTx) // 签名前修改交易
+	otherKey bool                      // 用另一个私钥签名
+	chainID  *big.Int                  // 按这个链ID签名，nil 表示使用请求中的链ID
+}
+
+// remoteSigner 启动一个假签名器，按 f 签名 method 请求，返回连接它的 RemoteSigner 和签名器的账户。
+func remoteSigner(t *testing.T, method string, f forgery) (*RemoteSigner, common.Address) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := fakerpc.New(t, func(m string, params json.RawMessage) fakerpc.Response {
+		switch m {
+		case "account_list", "eth_accounts":
+			return fakerpc.Response{Result: []common.Address{from}}
+		case method:
+		default:
+			return fakerpc.Response{Error: &fakerpc.Error{Code: -32601, Message: "method not found"}}
+		}
+		var args []sendTxArgs
+		if err := json.Unmarshal(params, &args); err != nil || len(args) != 1 {
+			t.Errorf("bad %s params %s: %v", m, params, err)
+			return fakerpc.Response{Error: &fakerpc.Error{Code: -32602, Message: "invalid params"}}
+		}
+		a := args[0]
+		if a.From != from {
+			t.Errorf("asked to sign for %s; want %s", a.From.Hex(), from.Hex())
+		}
+		inner := &types.DynamicFeeTx{
+			ChainID:   (*big.Int)(a.ChainID),
+			Nonce:     uint64(a.Nonce),
+			GasTipCap: (*big.Int)(a.MaxPriorityFeePerGas),
+			GasFeeCap: (*big.Int)(a.MaxFeePerGas),
+			Gas:       uint64(a.Gas),
+			To:        a.To,
+			Value:     (*big.Int)(a.Value),
+			Data:      a.Data,
+		}
+		signWith, chainID := key, inner.ChainID
+		if f.tamper != nil {
+			f.tamper(inner)
+		}
+		if f.otherKey {
+			signWith = otherKey
+		}
+		if f.chainID != nil {
+			inner.ChainID, chainID = f.chainID, f.chainID
+		}
+		signed, err := types.SignNewTx(signWith, types.LatestSignerForChainID(chainID), inner)
+		if err != nil {
+			t.Error(err)
+			return fakerpc.Response{Error: &fakerpc.Error{Code: -32000, Message: err.Error()}}
+		}
+		raw, err := signed.MarshalBinary()
+		if err != nil {
+			t.Error(err)
+		}
+		return fakerpc.Response{Result: map[string]hexutil.Bytes{"raw": raw}}
+	})
+	s, err := DialRemote(context.Background(), server.URL, common.Address{}, method)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s, from
+}
+
+func unsignedTx() *types.Transaction {
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+	})
+}
+
+func TestRemoteSignTx(t *testing.T) {
+	for _, method := range []string{MethodClef, MethodEth} {
+		t.Run(method, func(t *testing.T) {
+			s, from := remoteSigner(t, method, forgery{})
+			if s.Address() != from {
+				t.Fatalf("address = %s; want first account %s", s.Address().Hex(), from.Hex())
+			}
+			tx := unsignedTx()
+			signed, err := s.SignTx(context.Background(), tx, testChainID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signed)
+			if err != nil || sender != from {
+				t.Errorf("sender = %s, %v; want %s", sender.Hex(), err, from.Hex())
+			}
+			if signed.Nonce() != tx.Nonce() || *signed.To() != *tx.To() || signed.Value().Cmp(tx.Value()) != 0 {
+				t.Errorf("signed tx differs from request")
+			}
+		})
+	}
+}
+
+// 签名器返回的交易与请求不符、签名账户或链ID不对时都必须拒绝
+func TestRemoteSignTxRejectsTampering(t *testing.T) {
+	attacker := common.HexToAddress("0x00000000000000000000000000000000000BAD00")
+	for _, tc := range []struct {
+		name string
+		f    forgery
+		want string
+	}{
+		{"recipient", forgery{tamper: func(tx *types.DynamicFeeTx) { tx.To = &attacker }}, "recipient differs"},
+		{"value", forgery{tamper: func(tx *types.DynamicFeeTx) { tx.Value = big.NewInt(1e18) }}, "value"},
+		{"nonce", forgery{tamper: func(tx *types.DynamicFeeTx) { tx.Nonce++ }}, "nonce"},
+		{"data", forgery{tamper: func(tx *types.DynamicFeeTx) { tx.Data = []byte{1} }}, "data differs"},
+		{"fees", forgery{tamper: func(tx *types.DynamicFeeTx) { tx.GasFeeCap = big.NewInt(1e12) }}, "fee fields differ"},
+		{"wrong signer", forgery{otherKey: true}, "signed by"},
+		{"wrong chain", forgery{chainID: big.NewInt(1)}, "recover sender"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, _ := remoteSigner(t, MethodClef, tc.f)
+			signed, err := s.SignTx(context.Background(), unsignedTx(), testChainID)
+			if err == nil {
+				t.Fatalf("accepted tampered transaction %s", signed.Hash().Hex())
+			}
+			if !strings.Contains(err.Error(), "unexpected transaction") || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("err = %v; want rejection mentioning %q", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer 对交易签名。私钥可以在本地（环境变量、keystore、助记词），也可以在远程（Clef）。
+type Signer interface {
+	// Address 返回签名账户地址。
+	Address() common.Address
+	// SignTx 用指定链ID对交易签名，返回签名后的交易。
+	SignTx(ctx context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+// KeySigner 用内存中的 ECDSA 私钥签名。
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner 用私钥创建签名器。
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewHexSigner 解析十六进制私钥（可带 0x 前缀）。
+func NewHexSigner(hexKey string) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	return NewKeySigner(key), nil
+}
+
+// Address 实现 Signer。
+func (s *KeySigner) Address() common.Address { return s.address }
+
+// SignTx 实现 Signer。
+func (s *KeySigner) SignTx(_ context.Context, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+// TransactOpts 创建使用 Signer 签名的 bind.TransactOpts，供合约绑定调用。
+func TransactOpts(ctx context.Context, s Signer, chainID *big.Int) *bind.TransactOpts {
+	from := s.Address()
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.SignTx(ctx, tx, chainID)
+		},
+		Context: ctx,
+	}
+}
+
+// 签名器类型
+const (
+	KindEnv      = "env"      // 环境变量中的十六进制私钥
+	KindKeystore = "keystore" // 加密的 keystore JSON 文件
+	KindMnemonic = "mnemonic" // BIP-39 助记词 + BIP-44 路径
+	KindRemote   = "remote"   // Clef / eth_signTransaction 远程签名
+)
+
+// 环境变量名
+const (
+	EnvSigner           = "ETH_SIGNER"
+	EnvPrivateKey       = "ETH_PRIVATE_KEY"
+	EnvKeystore         = "ETH_KEYSTORE"
+	EnvKeystorePassword = "ETH_KEYSTORE_PASSWORD"
+	EnvKeystorePassFile = "ETH_KEYSTORE_PASSWORD_FILE"
+	EnvMnemonic         = "ETH_MNEMONIC"
+	EnvHDPath           = "ETH_HD_PATH"
+	EnvSignerURL        = "ETH_SIGNER_URL"
+	EnvSignerAddress    = "ETH_SIGNER_ADDRESS"
+	EnvSignerMethod     = "ETH_SIGNER_METHOD"
+)
+
+// Options 描述如何打开一个签名器。
+type Options struct {
+	Kind string
+
+	KeyEnv string // env：保存私钥的环境变量名，默认 ETH_PRIVATE_KEY
+
+	KeystoreFile string // keystore：JSON 文件路径
+	Password     string // keystore：解锁密码
+
+	Mnemonic string // mnemonic：助记词
+	HDPath   string // mnemonic：派生路径，默认 m/44'/60'/0'/0/0
+
+	RemoteURL     string         // remote：Clef 或节点的 RPC 地址
+	RemoteAddress common.Address // remote：签名账户
+	RemoteMethod  string         // remote：account_signTransaction（Clef，默认）或 eth_signTransaction
+}
+
+// OptionsFromEnv 从环境变量读取签名器配置。
+func OptionsFromEnv() (Options, error) {
+	opts := Options{
+		Kind:         os.Getenv(EnvSigner),
+		KeyEnv:       EnvPrivateKey,
+		KeystoreFile: os.Getenv(EnvKeystore),
+		Password:     os.Getenv(EnvKeystorePassword),
+		Mnemonic:     os.Getenv(EnvMnemonic),
+		HDPath:       os.Getenv(EnvHDPath),
+		RemoteURL:    os.Getenv(EnvSignerURL),
+		RemoteMethod: os.Getenv(EnvSignerMethod),
+	}
+	if file := os.Getenv(EnvKeystorePassFile); file != "" && opts.Password == "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return opts, fmt.Errorf("read keystore password file: %w", err)
+		}
+		opts.Password = strings.TrimRight(string(data), "\r\n")
+	}
+	if addr := os.Getenv(EnvSignerAddress); addr != "" {
+		if !common.IsHexAddress(addr) {
+			return opts, fmt.Errorf("invalid %s %q", EnvSignerAddress, addr)
+		}
+		opts.RemoteAddress = common.HexToAddress(addr)
+	}
+	return opts, nil
+}
+
+// Open 按配置创建签名器。Kind 为空时默认使用环境变量私钥。
+func Open(ctx context.Context, opts Options) (Signer, error) {
+	switch strings.ToLower(opts.Kind) {
+	case "", KindEnv:
+		name := opts.KeyEnv
+		if name == "" {
+			name = EnvPrivateKey
+		}
+		hexKey := os.Getenv(name)
+		if hexKey == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", name)
+		}
+		return NewHexSigner(hexKey)
+	case KindKeystore:
+		if opts.KeystoreFile == "" {
+			return nil, errors.New("keystore signer requires a keystore file")
+		}
+		return NewKeystoreSigner(opts.KeystoreFile, opts.Password)
+	case KindMnemonic:
+		if opts.Mnemonic == "" {
+			return nil, errors.New("mnemonic signer requires a mnemonic")
+		}
+		return NewMnemonicSigner(opts.Mnemonic, "", opts.HDPath)
+	case KindRemote:
+		if opts.RemoteURL == "" {
+			return nil, errors.New("remote signer requires a URL")
+		}
+		return DialRemote(ctx, opts.RemoteURL, opts.RemoteAddress, opts.RemoteMethod)
+	default:
+		return nil, fmt.Errorf("unknown signer kind %q (want env, keystore, mnemonic or remote)", opts.Kind)
+	}
+}
@@ -0,0 +1,5 @@
+// 用 soljson 的标准 JSON 接口编译：node compile.js <soljson.js>，输入从 stdin 读取，结果写到 stdout
+const fs = require('fs');
+const soljson = require(process.argv[2]);
+const compile = soljson.cwrap('solidity_compile', 'string', ['string', 'number', 'number']);
+process.stdout.write(compile(fs.readFileSync(0, 'utf8'), 0, 0));
@@ -0,0 +1,273 @@
+// Package solc 用固定版本的 Solidity 编译器编译合约，保证同一份源码在任何机器上得到相同的字节码。
+//
+// 编译器使用官方发布的 soljson（Emscripten 构建，与平台无关），通过 node 运行，
+// 下载后按 SHA-256 校验，并缓存在用户缓存目录中。编译参数（优化器、EVM 版本）也在这里固定。
+package solc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+)
+
+// 固定的编译器版本
+const (
+	Version = "0.8.30+commit.73712a01"
+	URL     = "https://binaries.soliditylang.org/bin/soljson-v" + Version + ".js"
+	SHA256  = "81475c98b6d2094a821fd9d7b6278556d8095ccc23e0b8a1029b1c08a89cd4b2"
+)
+
+// 固定的编译参数
+const (
+	EVMVersion     = "cancun"
+	OptimizerRuns  = 200
+	EnvSoljson     = "SOLJSON"      // 指定本地 soljson 文件，仍然会校验哈希
+	EnvNode        = "NODE"         // 指定 node 可执行文件，默认在 PATH 中查找
+	cacheSubdir    = "ethtool/solc" // 位于 os.UserCacheDir() 下
+	soljsonPattern = "soljson-v%s.js"
+)
+
+// ErrChecksum 表示 soljson 文件的哈希与固定值不符
+var ErrChecksum = errors.New("soljson checksum mismatch")
+
+// runner 是在 node 中调用 soljson 标准 JSON 接口的脚本
+//
+//go:embed compile.js
+var runner []byte
+
+// Contract 是一个合约的编译产物，字节码为不带 0x 前缀的十六进制
+type Contract struct {
+	Name       string
+	ABI        json.RawMessage
+	Bin        string           // 创建字节码
+	BinRuntime string           // 部署后的运行时字节码
+	Metadata   string           // 编译器元数据 JSON
+	Immutables []bytecode.Range // 运行时字节码中 immutable 变量的位置，按起点排序
+}
+
+// CachePath 返回缓存的 soljson 路径（文件可能不存在）。
+func CachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, cacheSubdir, fmt.Sprintf(soljsonPattern, Version)), nil
+}
+
+// Locate 返回已校验的 soljson 路径：优先使用 SOLJSON，其次是缓存。
+// 缓存中没有时，download 为 true 则从官方地址下载，否则返回 os.ErrNotExist。
+func Locate(ctx context.Context, download bool) (string, error) {
+	if path := os.Getenv(EnvSoljson); path != "" {
+		return path, verify(path)
+	}
+	path, err := CachePath()
+	if err != nil {
+		return "", err
+	}
+	if err := verify(path); err == nil || !errors.Is(err, os.ErrNotExist) {
+		return path, err
+	}
+	if !download {
+		return "", fmt.Errorf("soljson %s not cached at %s: %w", Version, path, os.ErrNotExist)
+	}
+	if err := fetch(ctx, path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// verify 校验文件的 SHA-256。
+func verify(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != SHA256 {
+		return fmt.Errorf("%w: %s has sha256 %s, want %s", ErrChecksum, path, got, SHA256)
+	}
+	return nil
+}
+
+// fetch 下载 soljson，校验通过后才移动到 path。
+func fetch(ctx context.Context, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("download %s: %w", URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: %s", URL, resp.Status)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "soljson-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, resp.Body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("download %s: %w", URL, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := verify(tmp.Name()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// input 是 solc 标准 JSON 输入
+type input struct {
+	Language string                       `json:"language"`
+	Sources  map[string]map[string]string `json:"sources"`
+	Settings settings                     `json:"settings"`
+}
+
+type settings struct {
+	Optimizer struct {
+		Enabled bool `json:"enabled"`
+		Runs    int  `json:"runs"`
+	} `json:"optimizer"`
+	EVMVersion      string                         `json:"evmVersion"`
+	OutputSelection map[string]map[string][]string `json:"outputSelection"`
+}
+
+// output 是 solc 标准 JSON 输出中用到的部分
+type output struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+	} `json:"errors"`
+	Contracts map[string]map[string]struct {
+		ABI      json.RawMessage `json:"abi"`
+		Metadata string          `json:"metadata"`
+		EVM      struct {
+			Bytecode         struct{ Object string } `json:"bytecode"`
+			DeployedBytecode struct {
+				Object              string
+				ImmutableReferences map[string][]bytecode.Range // 键是 AST 节点 ID
+			} `json:"deployedBytecode"`
+		} `json:"evm"`
+	} `json:"contracts"`
+}
+
+// Compile 用 soljson 编译 sources（文件名 -> 源码），返回按合约名排序的编译产物。
+// 源文件名会写进元数据，同一份源码必须使用相同的文件名才能得到相同的字节码。
+func Compile(ctx context.Context, soljson string, sources map[string]string) ([]*Contract, error) {
+	in := input{Language: "Solidity", Sources: map[string]map[string]string{}}
+	for name, content := range sources {
+		in.Sources[name] = map[string]string{"content": content}
+	}
+	in.Settings.Optimizer.Enabled = true
+	in.Settings.Optimizer.Runs = OptimizerRuns
+	in.Settings.EVMVersion = EVMVersion
+	in.Settings.OutputSelection = map[string]map[string][]string{
+		"*": {"*": {"abi", "metadata", "evm.bytecode.object", "evm.deployedBytecode.object", "evm.deployedBytecode.immutableReferences"}},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := run(ctx, soljson, data)
+	if err != nil {
+		return nil, err
+	}
+	var out output
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("parse solc output: %w", err)
+	}
+	var errs []string
+	for _, e := range out.Errors {
+		if e.Severity == "error" {
+			errs = append(errs, strings.TrimSpace(e.FormattedMessage))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("solc: %s", strings.Join(errs, "\n"))
+	}
+
+	var contracts []*Contract
+	for _, file := range out.Contracts {
+		for name, c := range file {
+			var immutables []bytecode.Range
+			for _, refs := range c.EVM.DeployedBytecode.ImmutableReferences {
+				immutables = append(immutables, refs...)
+			}
+			sort.Slice(immutables, func(i, j int) bool { return immutables[i].Start < immutables[j].Start })
+			contracts = append(contracts, &Contract{
+				Name:       name,
+				ABI:        c.ABI,
+				Bin:        c.EVM.Bytecode.Object,
+				BinRuntime: c.EVM.DeployedBytecode.Object,
+				Metadata:   c.Metadata,
+				Immutables: immutables,
+			})
+		}
+	}
+	sort.Slice(contracts, func(i, j int) bool { return contracts[i].Name < contracts[j].Name })
+	return contracts, nil
+}
+
+// run 在 node 中运行 soljson，标准 JSON 从 stdin 传入。
+func run(ctx context.Context, soljson string, stdin []byte) ([]byte, error) {
+	node := os.Getenv(EnvNode)
+	if node == "" {
+		var err error
+		if node, err = exec.LookPath("node"); err != nil {
+			return nil, fmt.Errorf("node is required to run soljson: %w", err)
+		}
+	}
+	script, err := os.CreateTemp("", "solc-*.js")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(script.Name())
+	if _, err := script.Write(runner); err != nil {
+		script.Close()
+		return nil, err
+	}
+	if err := script.Close(); err != nil {
+		return nil, err
+	}
+
+	soljson, err = filepath.Abs(soljson)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, node, script.Name(), soljson)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("run soljson: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,63 @@
+package txtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrReverted 表示交易已上链但执行失败（receipt.Status == ReceiptStatusFailed）。
+var ErrReverted = errors.New("transaction reverted")
+
+// RevertedError 携带执行失败的交易回执。
+type RevertedError struct {
+	TxHash  common.Hash
+	Receipt *types.Receipt
+}
+
+func (e *RevertedError) Error() string {
+	return fmt.Sprintf("%s: %s in block %d (gas used %d)", ErrReverted, e.TxHash.Hex(), e.Receipt.BlockNumber, e.Receipt.GasUsed)
+}
+
+func (e *RevertedError) Unwrap() error { return ErrReverted }
+
+// ReceiptBackend 是确认回执所需的 RPC 接口。
+type ReceiptBackend interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// inclusion 检查回执所在的区块是否仍在主链上，并返回当前确认数。
+// 节点可能在重组后短时间内仍返回旧回执，所以要用区块哈希比对而不是只看区块号。
+func inclusion(ctx context.Context, b ReceiptBackend, receipt *types.Receipt) (confirmations uint64, reorged bool, err error) {
+	header, err := b.HeaderByNumber(ctx, receipt.BlockNumber)
+	if errors.Is(err, ethereum.NotFound) {
+		// 链头回退到了回执所在高度之下
+		return 0, true, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("get header %d: %w", receipt.BlockNumber, err)
+	}
+	if header.Hash() != receipt.BlockHash {
+		return 0, true, nil
+	}
+	head, err := b.BlockNumber(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("get block number: %w", err)
+	}
+	return confirmationsAt(receipt, head), false, nil
+}
+
+// checkStatus 把执行失败的回执转换成 *RevertedError。
+func checkStatus(receipt *types.Receipt) error {
+	if receipt.Status == types.ReceiptStatusFailed {
+		return &RevertedError{TxHash: receipt.TxHash, Receipt: receipt}
+	}
+	return nil
+}
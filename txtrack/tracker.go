@@ -0,0 +1,260 @@
+package txtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/clc781032855/go_ethereum/chain"
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+// Status 是交易在生命周期中的状态。
+type Status string
+
+const (
+	StatusPending    Status = "pending"    // 已广播，尚未打包
+	StatusStuck      Status = "stuck"      // 超过 StuckAfter 仍未打包
+	StatusReplaced   Status = "replaced"   // 广播了同 nonce 的替换交易（加速或取消）
+	StatusMined      Status = "mined"      // 已打包，确认数不足
+	StatusConfirmed  Status = "confirmed"  // 达到要求的确认数
+	StatusSupplanted Status = "supplanted" // 同 nonce 被其它未跟踪的交易占用
+	StatusReorged    Status = "reorged"    // 打包所在的区块被重组掉，重新等待打包
+)
+
+// ErrSupplanted 表示 nonce 已被未跟踪的交易使用，被跟踪的交易永远不会上链。
+var ErrSupplanted = errors.New("transaction nonce was used by another transaction")
+
+// Update 是一次状态变化通知。
+type Update struct {
+	Status        Status
+	Tx            *types.Transaction // 当前相关的交易（替换后是新交易）
+	Receipt       *types.Receipt     // 打包后才有
+	Confirmations uint64
+}
+
+// Backend 是跟踪交易所需的 RPC 接口。
+type Backend interface {
+	gas.FeeBackend
+	ReceiptBackend
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Options 控制跟踪行为，零值字段使用默认值。
+type Options struct {
+	Confirmations uint64        // 需要的确认数，默认 1（打包即确认）
+	PollInterval  time.Duration // 轮询间隔，默认 4 秒
+	StuckAfter    time.Duration // 广播后超过这个时间仍未打包视为卡住，默认 3 分钟
+	AutoSpeedUp   int           // 卡住时自动加速的最大次数，0 表示只报告不处理
+	BumpPercent   uint64        // 每次加速的手续费涨幅，默认（也是最低）10%
+	Fees          gas.FeeConfig // 加速 / 取消时计算手续费的方式
+	OnUpdate      func(Update)  // 状态变化回调，可以为 nil
+}
+
+func (o *Options) setDefaults() {
+	if o.Confirmations == 0 {
+		o.Confirmations = 1
+	}
+	if o.PollInterval <= 0 {
+		o.PollInterval = 4 * time.Second
+	}
+	if o.StuckAfter <= 0 {
+		o.StuckAfter = 3 * time.Minute
+	}
+	if o.BumpPercent < gas.MinReplacementBump {
+		o.BumpPercent = gas.MinReplacementBump
+	}
+}
+
+// Tracker 跟踪交易从广播到确认的全过程，并支持同 nonce 的加速和取消。
+type Tracker struct {
+	backend Backend
+	signer  signer.Signer
+	chainID *big.Int
+	opts    Options
+}
+
+// New 创建交易跟踪器。signer 只用于加速和取消，只跟踪不替换时可以为 nil。
+func New(backend Backend, s signer.Signer, chainID *big.Int, opts Options) *Tracker {
+	opts.setDefaults()
+	return &Tracker{backend: backend, signer: s, chainID: chainID, opts: opts}
+}
+
+func (t *Tracker) notify(u Update) {
+	if t.opts.OnUpdate != nil {
+		t.opts.OnUpdate(u)
+	}
+}
+
+// SpeedUp 用相同 nonce、更高手续费重新广播交易。
+func (t *Tracker) SpeedUp(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	return t.replace(ctx, tx, tx.To(), tx.Value(), tx.Gas(), tx.Data())
+}
+
+// Cancel 用相同 nonce 向自己发送 0 ETH 来取消交易。
+func (t *Tracker) Cancel(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	if t.signer == nil {
+		return nil, errors.New("cancel requires a signer")
+	}
+	self := t.signer.Address()
+	return t.replace(ctx, tx, &self, new(big.Int), 21000, nil)
+}
+
+func (t *Tracker) replace(ctx context.Context, old *types.Transaction, to *common.Address, value *big.Int, gasLimit uint64, data []byte) (*types.Transaction, error) {
+	if t.signer == nil {
+		return nil, errors.New("replacing a transaction requires a signer")
+	}
+	suggested, err := gas.SuggestFees(ctx, t.backend, t.opts.Fees)
+	if err != nil {
+		return nil, err
+	}
+	fees := gas.BumpFees(old, suggested, t.opts.BumpPercent)
+	tx := fees.NewTx(t.chainID, old.Nonce(), to, value, gasLimit, data)
+	signed, err := t.signer.SignTx(ctx, tx, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("sign replacement: %w", err)
+	}
+	if err := t.backend.SendTransaction(ctx, signed); err != nil && !chain.IsKnownTx(err) {
+		return nil, fmt.Errorf("send replacement: %w", err)
+	}
+	t.notify(Update{Status: StatusReplaced, Tx: signed})
+	return signed, nil
+}
+
+// Track 跟踪交易直到达到要求的确认数，返回最终被打包的那笔交易的回执。
+// 打包所在区块被重组掉时会回到等待打包状态；交易执行失败时返回回执和 *RevertedError。
+// 期间通过 SpeedUp / Cancel 广播的替换交易需要用 TrackAll 一起跟踪；
+// 开启 AutoSpeedUp 时自动加速产生的替换交易会被自动跟踪。
+func (t *Tracker) Track(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	return t.TrackAll(ctx, []*types.Transaction{tx})
+}
+
+// TrackAll 跟踪一组同 nonce 的交易（原交易及其替换），任意一笔达到确认数即返回。
+func (t *Tracker) TrackAll(ctx context.Context, txs []*types.Transaction) (*types.Receipt, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("no transaction to track")
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(t.chainID), txs[0])
+	if err != nil {
+		return nil, fmt.Errorf("recover sender: %w", err)
+	}
+	candidates := append([]*types.Transaction(nil), txs...)
+	latest := candidates[len(candidates)-1]
+	broadcastAt := time.Now()
+	bumps := 0
+	stuckReported := false
+	var lastConfirmations uint64
+
+	t.notify(Update{Status: StatusPending, Tx: latest})
+
+	ticker := time.NewTicker(t.opts.PollInterval)
+	defer ticker.Stop()
+	for {
+		tx, receipt, err := t.findReceipt(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			confirmations, reorged, err := inclusion(ctx, t.backend, receipt)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case reorged:
+				if lastConfirmations > 0 {
+					t.notify(Update{Status: StatusReorged, Tx: tx, Receipt: receipt})
+					lastConfirmations = 0
+				}
+			case confirmations >= t.opts.Confirmations:
+				t.notify(Update{Status: StatusConfirmed, Tx: tx, Receipt: receipt, Confirmations: confirmations})
+				return receipt, checkStatus(receipt)
+			case confirmations != lastConfirmations:
+				t.notify(Update{Status: StatusMined, Tx: tx, Receipt: receipt, Confirmations: confirmations})
+				lastConfirmations = confirmations
+			}
+		} else {
+			if lastConfirmations > 0 {
+				// 回执消失：打包所在的区块被重组掉，交易回到了交易池
+				t.notify(Update{Status: StatusReorged, Tx: latest})
+				lastConfirmations = 0
+			}
+			// 没有任何候选交易被打包，但账户 nonce 已经越过：被其它交易占用了
+			mined, err := t.backend.NonceAt(ctx, sender, nil)
+			if err != nil {
+				return nil, fmt.Errorf("get account nonce: %w", err)
+			}
+			if mined > latest.Nonce() {
+				// 再查一次，避免打包发生在两次查询之间
+				if tx, receipt, err = t.findReceipt(ctx, candidates); err != nil {
+					return nil, err
+				}
+				if receipt == nil {
+					t.notify(Update{Status: StatusSupplanted, Tx: latest})
+					return nil, ErrSupplanted
+				}
+				continue
+			}
+			if time.Since(broadcastAt) > t.opts.StuckAfter {
+				if bumps < t.opts.AutoSpeedUp {
+					replacement, err := t.SpeedUp(ctx, latest)
+					if err != nil {
+						return nil, err
+					}
+					candidates = append(candidates, replacement)
+					latest, broadcastAt, stuckReported = replacement, time.Now(), false
+					bumps++
+				} else if !stuckReported {
+					t.notify(Update{Status: StatusStuck, Tx: latest})
+					stuckReported = true
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// findReceipt 查找候选交易中已被打包的那一笔。
+func (t *Tracker) findReceipt(ctx context.Context, candidates []*types.Transaction) (*types.Transaction, *types.Receipt, error) {
+	for _, tx := range candidates {
+		receipt, err := t.backend.TransactionReceipt(ctx, tx.Hash())
+		if err == nil {
+			return tx, receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, nil, fmt.Errorf("get receipt of %s: %w", tx.Hash().Hex(), err)
+		}
+	}
+	return nil, nil, nil
+}
+
+// confirmationsAt 计算回执在链头 head 时的确认数，打包所在的区块算 1 个确认。
+func confirmationsAt(receipt *types.Receipt, head uint64) uint64 {
+	block := receipt.BlockNumber.Uint64()
+	if head < block {
+		return 0
+	}
+	return head - block + 1
+}
+
+// ConfirmedHead 返回链头为 head 时至少有 depth 个确认的最新区块，确认数的算法与 Tracker 相同：
+// 区块本身算 1 个确认，depth 为 0 时按 1 处理。链还不够长时 ok 为 false。
+func ConfirmedHead(head, depth uint64) (block uint64, ok bool) {
+	depth = max(depth, 1)
+	if head+1 < depth {
+		return 0, false
+	}
+	return head + 1 - depth, true
+}
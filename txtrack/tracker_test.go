@@ -0,0 +1,28 @@
+package txtrack_test
+
+import (
+	"testing"
+
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+func TestConfirmedHead(t *testing.T) {
+	tests := []struct {
+		head, depth uint64
+		want        uint64
+		ok          bool
+	}{
+		{head: 10, depth: 0, want: 10, ok: true}, // 0 按 1 处理
+		{head: 10, depth: 1, want: 10, ok: true}, // 链头本身有 1 个确认
+		{head: 10, depth: 3, want: 8, ok: true},
+		{head: 2, depth: 3, want: 0, ok: true},
+		{head: 1, depth: 3, ok: false},
+		{head: 0, depth: 1, want: 0, ok: true},
+	}
+	for _, tt := range tests {
+		got, ok := txtrack.ConfirmedHead(tt.head, tt.depth)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ConfirmedHead(%d, %d) = %d, %v; want %d, %v", tt.head, tt.depth, got, ok, tt.want, tt.ok)
+		}
+	}
+}
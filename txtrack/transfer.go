@@ -0,0 +1,89 @@
+package txtrack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/nonce"
+	"github.com/clc781032855/go_ethereum/signer"
+)
+
+// Transfer 描述一笔 ETH 转账。
+type Transfer struct {
+	To       common.Address
+	Value    *big.Int
+	Fees     gas.FeeConfig
+	Estimate gas.EstimateConfig
+}
+
+// TransferBackend 是发送转账所需的 RPC 接口。
+type TransferBackend interface {
+	nonce.Source
+	gas.FeeBackend
+	gas.EstimateBackend
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// TransferStep 是转账流程中的一步。
+type TransferStep string
+
+const (
+	StepNonce    TransferStep = "nonce"    // 分配 nonce
+	StepFees     TransferStep = "fees"     // 获取手续费
+	StepEstimate TransferStep = "estimate" // 估算 gas
+	StepSign     TransferStep = "sign"     // 签名
+	StepSend     TransferStep = "send"     // 广播
+)
+
+// TransferError 表示转账在 Step 这一步失败，此时交易没有被节点接受。
+type TransferError struct {
+	Step TransferStep
+	Err  error
+}
+
+func (e *TransferError) Error() string {
+	return fmt.Sprintf("transfer %s: %v", e.Step, e.Err)
+}
+
+func (e *TransferError) Unwrap() error { return e.Err }
+
+// SendTransfer 分配 nonce，按建议的手续费和估算的 gas 构造转账，签名后广播，返回已签名的交易和使用的手续费。
+// 节点接受交易后提交 nonce；任何一步失败时归还 nonce 并返回 *TransferError。
+// 向没有 receive 函数的合约转账会在估算阶段失败，不会占用 nonce。
+func SendTransfer(ctx context.Context, b TransferBackend, nonces *nonce.Manager, s signer.Signer, chainID *big.Int, t Transfer) (*types.Transaction, *gas.Fees, error) {
+	from := s.Address()
+	reservation, err := nonces.Reserve(ctx, b, chainID, from)
+	if err != nil {
+		return nil, nil, &TransferError{Step: StepNonce, Err: err}
+	}
+	abandon := func(step TransferStep, err error) (*types.Transaction, *gas.Fees, error) {
+		reservation.Abandon(err)
+		return nil, nil, &TransferError{Step: step, Err: err}
+	}
+
+	// 支持 London 的链使用 EIP-1559，否则退回 legacy gasPrice
+	fees, err := gas.SuggestFees(ctx, b, t.Fees)
+	if err != nil {
+		return abandon(StepFees, err)
+	}
+	// 向合约地址转账时会超过 21000
+	gasLimit, err := gas.EstimateGas(ctx, b, ethereum.CallMsg{From: from, To: &t.To, Value: t.Value}, t.Estimate)
+	if err != nil {
+		return abandon(StepEstimate, err)
+	}
+	signed, err := s.SignTx(ctx, fees.NewTx(chainID, reservation.Nonce, &t.To, t.Value, gasLimit, nil), chainID)
+	if err != nil {
+		return abandon(StepSign, err)
+	}
+	if err := b.SendTransaction(ctx, signed); err != nil {
+		return abandon(StepSend, err)
+	}
+	reservation.Commit()
+	return signed, fees, nil
+}
@@ -0,0 +1,125 @@
+package txtrack_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/clc781032855/go_ethereum/counter"
+	"github.com/clc781032855/go_ethereum/gas"
+	"github.com/clc781032855/go_ethereum/internal/simtest"
+	"github.com/clc781032855/go_ethereum/nonce"
+	"github.com/clc781032855/go_ethereum/signer"
+	"github.com/clc781032855/go_ethereum/txtrack"
+)
+
+var chainID = simtest.ChainID
+
+func newBackend(t *testing.T) (*simulated.Backend, *signer.KeySigner) {
+	t.Helper()
+	s := signer.NewKeySigner(simtest.NewKey(t))
+	return simtest.NewBackend(t, nil, s.Address()), s
+}
+
+// transfer 用默认的估算配置发送一笔转账
+func transfer(ctx context.Context, client simulated.Client, nonces *nonce.Manager, s signer.Signer, to common.Address, value *big.Int, mode gas.FeeMode) (*types.Transaction, error) {
+	tx, _, err := txtrack.SendTransfer(ctx, client, nonces, s, chainID, txtrack.Transfer{To: to, Value: value, Fees: gas.FeeConfig{Mode: mode}})
+	return tx, err
+}
+
+func TestTransfer(t *testing.T) {
+	ctx := context.Background()
+	sim, s := newBackend(t)
+	client := sim.Client()
+	nonces := nonce.NewManager()
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	value := big.NewInt(params.GWei)
+
+	for i, mode := range []gas.FeeMode{gas.FeeModeDynamic, gas.FeeModeLegacy} {
+		before, err := client.BalanceAt(ctx, s.Address(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := transfer(ctx, client, nonces, s, to, value, mode)
+		if err != nil {
+			t.Fatalf("%s: %v", mode, err)
+		}
+		if tx.Nonce() != uint64(i) {
+			t.Errorf("%s: nonce = %d; want %d", mode, tx.Nonce(), i)
+		}
+		if wantType := map[gas.FeeMode]uint8{gas.FeeModeDynamic: types.DynamicFeeTxType, gas.FeeModeLegacy: types.LegacyTxType}[mode]; tx.Type() != wantType {
+			t.Errorf("%s: tx type = %d; want %d", mode, tx.Type(), wantType)
+		}
+		// 打包后再出一个块，凑够两个确认
+		sim.Commit()
+		sim.Commit()
+
+		var statuses []txtrack.Status
+		tracker := txtrack.New(client, s, chainID, txtrack.Options{
+			Confirmations: 2,
+			OnUpdate:      func(u txtrack.Update) { statuses = append(statuses, u.Status) },
+		})
+		receipt, err := tracker.Track(ctx, tx)
+		if err != nil {
+			t.Fatalf("%s: track: %v", mode, err)
+		}
+		if receipt.TxHash != tx.Hash() || receipt.GasUsed != params.TxGas {
+			t.Errorf("%s: receipt %s used %d gas", mode, receipt.TxHash.Hex(), receipt.GasUsed)
+		}
+		if last := statuses[len(statuses)-1]; last != txtrack.StatusConfirmed {
+			t.Errorf("%s: last status = %s; want confirmed", mode, last)
+		}
+
+		after, err := client.BalanceAt(ctx, s.Address(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+		spent := new(big.Int).Sub(before, after)
+		if want := new(big.Int).Add(value, fee); spent.Cmp(want) != 0 {
+			t.Errorf("%s: sender spent %s; want value + fee = %s", mode, spent, want)
+		}
+	}
+
+	got, err := client.BalanceAt(ctx, to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := new(big.Int).Mul(value, big.NewInt(2)); got.Cmp(want) != 0 {
+		t.Errorf("receiver balance = %s; want %s", got, want)
+	}
+}
+
+// Counter 没有 receive 函数，向它转账在估算阶段就会失败，nonce 不会被占用
+func TestTransferToContractWithoutReceive(t *testing.T) {
+	ctx := context.Background()
+	sim, s := newBackend(t)
+	client := sim.Client()
+	auth := signer.TransactOpts(ctx, s, chainID)
+	address, _, _, err := counter.DeployCounter(auth, client, auth.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	nonces := nonce.NewManager()
+	_, err = transfer(ctx, client, nonces, s, address, big.NewInt(1), gas.FeeModeAuto)
+	var te *txtrack.TransferError
+	if !errors.As(err, &te) || te.Step != txtrack.StepEstimate || !errors.Is(err, gas.ErrEstimateFailed) {
+		t.Fatalf("transfer to Counter: %v; want ErrEstimateFailed at the estimate step", err)
+	}
+	reservation, err := nonces.Reserve(ctx, client, chainID, s.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reservation.Abandon(nil)
+	if reservation.Nonce != 1 {
+		t.Errorf("nonce after failed transfer = %d; want 1", reservation.Nonce)
+	}
+}
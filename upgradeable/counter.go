@@ -0,0 +1,1275 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package upgradeable
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CounterUpgradeableMetaData contains all meta data concerning the CounterUpgradeable contract.
+var CounterUpgradeableMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"ERC1967InvalidImplementation\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"UUPSUnauthorizedCallContext\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"slot\",\"type\":\"bytes32\"}],\"name\":\"UUPSUnsupportedProxiableUUID\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Decremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Incremented\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"newCount\",\"type\":\"uint256\"}],\"name\":\"Reset\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"decrement\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"increment\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"initialOwner\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"proxiableUUID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"reset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60a0604052306080523480156012575f5ffd5b505f805460ff1916600117905560805161084e6100455f395f81816101f8015281816102210152610466015261084e5ff3fe608060405234801561000f575f5ffd5b506004361061009b575f3560e01c8063a87d942c11610063578063a87d942c1461010a578063c4d66de814610112578063d09de08a14610125578063d826f88f1461012d578063f2fde38b14610135575f5ffd5b80632baeceb71461009f5780634f1ef286146100a957806352d1902d146100bc578063715018a6146100d75780638da5cb5b146100df575b5f5ffd5b6100a7610148565b005b6100a76100b73660046106f4565b6101ed565b6100c461045a565b6040519081526020015b60405180910390f35b6100a76104b6565b6002546100f2906001600160a01b031681565b6040516001600160a01b0390911681526020016100ce565b6001546100c4565b6100a7610120366004610772565b6104ee565b6100a761057a565b6100a76105c3565b6100a7610143366004610772565b610626565b5f6001541161019e5760405162461bcd60e51b815260206004820152601860248201527f436f756e742063616e6e6f74206265206e65676174697665000000000000000060448201526064015b60405180910390fd5b6001805f8282546101af91906107a6565b90915550506001546040519081527fc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a906020015b60405180910390a1565b306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016148061026a57507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661025e5f5160206107f95f395f51905f525490565b6001600160a01b031614155b156102885760405163703e46dd60e11b815260040160405180910390fd5b6002546001600160a01b031633146102b55760405163118cdaa760e01b8152336004820152602401610195565b826001600160a01b03163b5f036102ea57604051634c9c8ce360e01b81526001600160a01b0384166004820152602401610195565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015610344575060408051601f3d908101601f19168201909252610341918101906107bf565b60015b61036c57604051634c9c8ce360e01b81526001600160a01b0384166004820152602401610195565b5f5160206107f95f395f51905f52811461039c57604051632a87526960e21b815260048101829052602401610195565b505f5160206107f95f395f51905f528390556040516001600160a01b038416907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b905f90a28015610455575f5f846001600160a01b031684846040516104039291906107d6565b5f60405180830381855af49150503d805f811461043b576040519150601f19603f3d011682016040523d82523d5f602084013e610440565b606091505b50915091508161045257805160208201fd5b50505b505050565b5f306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146104a45760405163703e46dd60e11b815260040160405180910390fd5b505f5160206107f95f395f51905f5290565b6002546001600160a01b031633146104e35760405163118cdaa760e01b8152336004820152602401610195565b6104ec5f610688565b565b5f5460ff16156105115760405163f92ee8a960e01b815260040160405180910390fd5b6001600160a01b03811661053a57604051631e4fbdf760e01b81525f6004820152602401610195565b5f805460ff1916600117905561054f81610688565b6040517f5daa87a0e9463431830481fd4b6e3403442dfb9a12b9c07597e9f61d50b633c8905f90a150565b6001805f82825461058b91906107e5565b90915550506001546040519081527f20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d84906020016101e3565b6002546001600160a01b031633146105f05760405163118cdaa760e01b8152336004820152602401610195565b5f60018190556040519081527f01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf6906020016101e3565b6002546001600160a01b031633146106535760405163118cdaa760e01b8152336004820152602401610195565b6001600160a01b03811661067c57604051631e4fbdf760e01b81525f6004820152602401610195565b61068581610688565b50565b600280546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b80356001600160a01b03811681146106ef575f5ffd5b919050565b5f5f5f60408486031215610706575f5ffd5b61070f846106d9565b9250602084013567ffffffffffffffff81111561072a575f5ffd5b8401601f8101861361073a575f5ffd5b803567ffffffffffffffff811115610750575f5ffd5b866020828401011115610761575f5ffd5b939660209190910195509293505050565b5f60208284031215610782575f5ffd5b61078b826106d9565b9392505050565b634e487b7160e01b5f52601160045260245ffd5b818103818111156107b9576107b9610792565b92915050565b5f602082840312156107cf575f5ffd5b5051919050565b818382375f9101908152919050565b808201808211156107b9576107b961079256fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbca2646970667358221220be513d548125b030847a6fc5c4db427093ca3b926dea350ba07bc669ce57e86664736f6c634300081e0033",
+}
+
+// CounterUpgradeableABI is the input ABI used to generate the binding from.
+// Deprecated: Use CounterUpgradeableMetaData.ABI instead.
+var CounterUpgradeableABI = CounterUpgradeableMetaData.ABI
+
+// CounterUpgradeableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CounterUpgradeableMetaData.Bin instead.
+var CounterUpgradeableBin = CounterUpgradeableMetaData.Bin
+
+// DeployCounterUpgradeable deploys a new Ethereum contract, binding an instance of CounterUpgradeable to it.
+func DeployCounterUpgradeable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *CounterUpgradeable, error) {
+	parsed, err := CounterUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CounterUpgradeableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CounterUpgradeable{CounterUpgradeableCaller: CounterUpgradeableCaller{contract: contract}, CounterUpgradeableTransactor: CounterUpgradeableTransactor{contract: contract}, CounterUpgradeableFilterer: CounterUpgradeableFilterer{contract: contract}}, nil
+}
+
+// CounterUpgradeable is an auto generated Go binding around an Ethereum contract.
+type CounterUpgradeable struct {
+	CounterUpgradeableCaller     // Read-only binding to the contract
+	CounterUpgradeableTransactor // Write-only binding to the contract
+	CounterUpgradeableFilterer   // Log filterer for contract events
+}
+
+// CounterUpgradeableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CounterUpgradeableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CounterUpgradeableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CounterUpgradeableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CounterUpgradeableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CounterUpgradeableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CounterUpgradeableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CounterUpgradeableSession struct {
+	Contract     *CounterUpgradeable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// CounterUpgradeableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CounterUpgradeableCallerSession struct {
+	Contract *CounterUpgradeableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// CounterUpgradeableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CounterUpgradeableTransactorSession struct {
+	Contract     *CounterUpgradeableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// CounterUpgradeableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CounterUpgradeableRaw struct {
+	Contract *CounterUpgradeable // Generic contract binding to access the raw methods on
+}
+
+// CounterUpgradeableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CounterUpgradeableCallerRaw struct {
+	Contract *CounterUpgradeableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CounterUpgradeableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CounterUpgradeableTransactorRaw struct {
+	Contract *CounterUpgradeableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCounterUpgradeable creates a new instance of CounterUpgradeable, bound to a specific deployed contract.
+func NewCounterUpgradeable(address common.Address, backend bind.ContractBackend) (*CounterUpgradeable, error) {
+	contract, err := bindCounterUpgradeable(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeable{CounterUpgradeableCaller: CounterUpgradeableCaller{contract: contract}, CounterUpgradeableTransactor: CounterUpgradeableTransactor{contract: contract}, CounterUpgradeableFilterer: CounterUpgradeableFilterer{contract: contract}}, nil
+}
+
+// NewCounterUpgradeableCaller creates a new read-only instance of CounterUpgradeable, bound to a specific deployed contract.
+func NewCounterUpgradeableCaller(address common.Address, caller bind.ContractCaller) (*CounterUpgradeableCaller, error) {
+	contract, err := bindCounterUpgradeable(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableCaller{contract: contract}, nil
+}
+
+// NewCounterUpgradeableTransactor creates a new write-only instance of CounterUpgradeable, bound to a specific deployed contract.
+func NewCounterUpgradeableTransactor(address common.Address, transactor bind.ContractTransactor) (*CounterUpgradeableTransactor, error) {
+	contract, err := bindCounterUpgradeable(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableTransactor{contract: contract}, nil
+}
+
+// NewCounterUpgradeableFilterer creates a new log filterer instance of CounterUpgradeable, bound to a specific deployed contract.
+func NewCounterUpgradeableFilterer(address common.Address, filterer bind.ContractFilterer) (*CounterUpgradeableFilterer, error) {
+	contract, err := bindCounterUpgradeable(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableFilterer{contract: contract}, nil
+}
+
+// bindCounterUpgradeable binds a generic wrapper to an already deployed contract.
+func bindCounterUpgradeable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CounterUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CounterUpgradeable *CounterUpgradeableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CounterUpgradeable.Contract.CounterUpgradeableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CounterUpgradeable *CounterUpgradeableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.CounterUpgradeableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CounterUpgradeable *CounterUpgradeableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.CounterUpgradeableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CounterUpgradeable *CounterUpgradeableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CounterUpgradeable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CounterUpgradeable *CounterUpgradeableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CounterUpgradeable *CounterUpgradeableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetCount is a free data retrieval call binding the contract method 0xa87d942c.
+//
+// Solidity: function getCount() view returns(uint256)
+func (_CounterUpgradeable *CounterUpgradeableCaller) GetCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CounterUpgradeable.contract.Call(opts, &out, "getCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCount is a free data retrieval call binding the contract method 0xa87d942c.
+//
+// Solidity: function getCount() view returns(uint256)
+func (_CounterUpgradeable *CounterUpgradeableSession) GetCount() (*big.Int, error) {
+	return _CounterUpgradeable.Contract.GetCount(&_CounterUpgradeable.CallOpts)
+}
+
+// GetCount is a free data retrieval call binding the contract method 0xa87d942c.
+//
+// Solidity: function getCount() view returns(uint256)
+func (_CounterUpgradeable *CounterUpgradeableCallerSession) GetCount() (*big.Int, error) {
+	return _CounterUpgradeable.Contract.GetCount(&_CounterUpgradeable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CounterUpgradeable *CounterUpgradeableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CounterUpgradeable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CounterUpgradeable *CounterUpgradeableSession) Owner() (common.Address, error) {
+	return _CounterUpgradeable.Contract.Owner(&_CounterUpgradeable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_CounterUpgradeable *CounterUpgradeableCallerSession) Owner() (common.Address, error) {
+	return _CounterUpgradeable.Contract.Owner(&_CounterUpgradeable.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CounterUpgradeable *CounterUpgradeableCaller) ProxiableUUID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CounterUpgradeable.contract.Call(opts, &out, "proxiableUUID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CounterUpgradeable *CounterUpgradeableSession) ProxiableUUID() ([32]byte, error) {
+	return _CounterUpgradeable.Contract.ProxiableUUID(&_CounterUpgradeable.CallOpts)
+}
+
+// ProxiableUUID is a free data retrieval call binding the contract method 0x52d1902d.
+//
+// Solidity: function proxiableUUID() view returns(bytes32)
+func (_CounterUpgradeable *CounterUpgradeableCallerSession) ProxiableUUID() ([32]byte, error) {
+	return _CounterUpgradeable.Contract.ProxiableUUID(&_CounterUpgradeable.CallOpts)
+}
+
+// Decrement is a paid mutator transaction binding the contract method 0x2baeceb7.
+//
+// Solidity: function decrement() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) Decrement(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "decrement")
+}
+
+// Decrement is a paid mutator transaction binding the contract method 0x2baeceb7.
+//
+// Solidity: function decrement() returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) Decrement() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Decrement(&_CounterUpgradeable.TransactOpts)
+}
+
+// Decrement is a paid mutator transaction binding the contract method 0x2baeceb7.
+//
+// Solidity: function decrement() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) Decrement() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Decrement(&_CounterUpgradeable.TransactOpts)
+}
+
+// Increment is a paid mutator transaction binding the contract method 0xd09de08a.
+//
+// Solidity: function increment() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) Increment(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "increment")
+}
+
+// Increment is a paid mutator transaction binding the contract method 0xd09de08a.
+//
+// Solidity: function increment() returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) Increment() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Increment(&_CounterUpgradeable.TransactOpts)
+}
+
+// Increment is a paid mutator transaction binding the contract method 0xd09de08a.
+//
+// Solidity: function increment() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) Increment() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Increment(&_CounterUpgradeable.TransactOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address initialOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) Initialize(opts *bind.TransactOpts, initialOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "initialize", initialOwner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address initialOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) Initialize(initialOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Initialize(&_CounterUpgradeable.TransactOpts, initialOwner)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4d66de8.
+//
+// Solidity: function initialize(address initialOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) Initialize(initialOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Initialize(&_CounterUpgradeable.TransactOpts, initialOwner)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.RenounceOwnership(&_CounterUpgradeable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.RenounceOwnership(&_CounterUpgradeable.TransactOpts)
+}
+
+// Reset is a paid mutator transaction binding the contract method 0xd826f88f.
+//
+// Solidity: function reset() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) Reset(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "reset")
+}
+
+// Reset is a paid mutator transaction binding the contract method 0xd826f88f.
+//
+// Solidity: function reset() returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) Reset() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Reset(&_CounterUpgradeable.TransactOpts)
+}
+
+// Reset is a paid mutator transaction binding the contract method 0xd826f88f.
+//
+// Solidity: function reset() returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) Reset() (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.Reset(&_CounterUpgradeable.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.TransferOwnership(&_CounterUpgradeable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.TransferOwnership(&_CounterUpgradeable.TransactOpts, newOwner)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CounterUpgradeable.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) returns()
+func (_CounterUpgradeable *CounterUpgradeableSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.UpgradeToAndCall(&_CounterUpgradeable.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) returns()
+func (_CounterUpgradeable *CounterUpgradeableTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _CounterUpgradeable.Contract.UpgradeToAndCall(&_CounterUpgradeable.TransactOpts, newImplementation, data)
+}
+
+// CounterUpgradeableDecrementedIterator is returned from FilterDecremented and is used to iterate over the raw logs and unpacked data for Decremented events raised by the CounterUpgradeable contract.
+type CounterUpgradeableDecrementedIterator struct {
+	Event *CounterUpgradeableDecremented // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableDecrementedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableDecremented)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableDecremented)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableDecrementedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableDecrementedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableDecremented represents a Decremented event raised by the CounterUpgradeable contract.
+type CounterUpgradeableDecremented struct {
+	NewCount *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDecremented is a free log retrieval operation binding the contract event 0xc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a.
+//
+// Solidity: event Decremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterDecremented(opts *bind.FilterOpts) (*CounterUpgradeableDecrementedIterator, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "Decremented")
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableDecrementedIterator{contract: _CounterUpgradeable.contract, event: "Decremented", logs: logs, sub: sub}, nil
+}
+
+// WatchDecremented is a free log subscription operation binding the contract event 0xc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a.
+//
+// Solidity: event Decremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchDecremented(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableDecremented) (event.Subscription, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "Decremented")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableDecremented)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "Decremented", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDecremented is a log parse operation binding the contract event 0xc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a.
+//
+// Solidity: event Decremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseDecremented(log types.Log) (*CounterUpgradeableDecremented, error) {
+	event := new(CounterUpgradeableDecremented)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "Decremented", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CounterUpgradeableIncrementedIterator is returned from FilterIncremented and is used to iterate over the raw logs and unpacked data for Incremented events raised by the CounterUpgradeable contract.
+type CounterUpgradeableIncrementedIterator struct {
+	Event *CounterUpgradeableIncremented // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableIncrementedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableIncremented)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableIncremented)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableIncrementedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableIncrementedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableIncremented represents a Incremented event raised by the CounterUpgradeable contract.
+type CounterUpgradeableIncremented struct {
+	NewCount *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterIncremented is a free log retrieval operation binding the contract event 0x20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d84.
+//
+// Solidity: event Incremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterIncremented(opts *bind.FilterOpts) (*CounterUpgradeableIncrementedIterator, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "Incremented")
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableIncrementedIterator{contract: _CounterUpgradeable.contract, event: "Incremented", logs: logs, sub: sub}, nil
+}
+
+// WatchIncremented is a free log subscription operation binding the contract event 0x20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d84.
+//
+// Solidity: event Incremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchIncremented(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableIncremented) (event.Subscription, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "Incremented")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableIncremented)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "Incremented", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseIncremented is a log parse operation binding the contract event 0x20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d84.
+//
+// Solidity: event Incremented(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseIncremented(log types.Log) (*CounterUpgradeableIncremented, error) {
+	event := new(CounterUpgradeableIncremented)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "Incremented", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CounterUpgradeableInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the CounterUpgradeable contract.
+type CounterUpgradeableInitializedIterator struct {
+	Event *CounterUpgradeableInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableInitialized represents a Initialized event raised by the CounterUpgradeable contract.
+type CounterUpgradeableInitialized struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0x5daa87a0e9463431830481fd4b6e3403442dfb9a12b9c07597e9f61d50b633c8.
+//
+// Solidity: event Initialized()
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterInitialized(opts *bind.FilterOpts) (*CounterUpgradeableInitializedIterator, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableInitializedIterator{contract: _CounterUpgradeable.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0x5daa87a0e9463431830481fd4b6e3403442dfb9a12b9c07597e9f61d50b633c8.
+//
+// Solidity: event Initialized()
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableInitialized)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0x5daa87a0e9463431830481fd4b6e3403442dfb9a12b9c07597e9f61d50b633c8.
+//
+// Solidity: event Initialized()
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseInitialized(log types.Log) (*CounterUpgradeableInitialized, error) {
+	event := new(CounterUpgradeableInitialized)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CounterUpgradeableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the CounterUpgradeable contract.
+type CounterUpgradeableOwnershipTransferredIterator struct {
+	Event *CounterUpgradeableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableOwnershipTransferred represents a OwnershipTransferred event raised by the CounterUpgradeable contract.
+type CounterUpgradeableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CounterUpgradeableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableOwnershipTransferredIterator{contract: _CounterUpgradeable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableOwnershipTransferred)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseOwnershipTransferred(log types.Log) (*CounterUpgradeableOwnershipTransferred, error) {
+	event := new(CounterUpgradeableOwnershipTransferred)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CounterUpgradeableResetIterator is returned from FilterReset and is used to iterate over the raw logs and unpacked data for Reset events raised by the CounterUpgradeable contract.
+type CounterUpgradeableResetIterator struct {
+	Event *CounterUpgradeableReset // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableResetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableReset)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableReset)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableResetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableResetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableReset represents a Reset event raised by the CounterUpgradeable contract.
+type CounterUpgradeableReset struct {
+	NewCount *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterReset is a free log retrieval operation binding the contract event 0x01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf6.
+//
+// Solidity: event Reset(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterReset(opts *bind.FilterOpts) (*CounterUpgradeableResetIterator, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "Reset")
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableResetIterator{contract: _CounterUpgradeable.contract, event: "Reset", logs: logs, sub: sub}, nil
+}
+
+// WatchReset is a free log subscription operation binding the contract event 0x01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf6.
+//
+// Solidity: event Reset(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchReset(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableReset) (event.Subscription, error) {
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "Reset")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableReset)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "Reset", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseReset is a log parse operation binding the contract event 0x01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf6.
+//
+// Solidity: event Reset(uint256 newCount)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseReset(log types.Log) (*CounterUpgradeableReset, error) {
+	event := new(CounterUpgradeableReset)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "Reset", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// CounterUpgradeableUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the CounterUpgradeable contract.
+type CounterUpgradeableUpgradedIterator struct {
+	Event *CounterUpgradeableUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *CounterUpgradeableUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(CounterUpgradeableUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(CounterUpgradeableUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *CounterUpgradeableUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *CounterUpgradeableUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// CounterUpgradeableUpgraded represents a Upgraded event raised by the CounterUpgradeable contract.
+type CounterUpgradeableUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*CounterUpgradeableUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _CounterUpgradeable.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &CounterUpgradeableUpgradedIterator{contract: _CounterUpgradeable.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *CounterUpgradeableUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _CounterUpgradeable.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(CounterUpgradeableUpgraded)
+				if err := _CounterUpgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_CounterUpgradeable *CounterUpgradeableFilterer) ParseUpgraded(log types.Log) (*CounterUpgradeableUpgraded, error) {
+	event := new(CounterUpgradeableUpgraded)
+	if err := _CounterUpgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
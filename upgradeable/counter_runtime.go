@@ -0,0 +1,20 @@
+// Code generated by contractgen - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package upgradeable
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+)
+
+// CounterUpgradeableRuntime 是 CounterUpgradeable 部署后的运行时字节码，用于校验链上代码。
+var CounterUpgradeableRuntime = &bytecode.Runtime{
+	Code: common.FromHex("0x608060405234801561000f575f5ffd5b506004361061009b575f3560e01c8063a87d942c11610063578063a87d942c1461010a578063c4d66de814610112578063d09de08a14610125578063d826f88f1461012d578063f2fde38b14610135575f5ffd5b80632baeceb71461009f5780634f1ef286146100a957806352d1902d146100bc578063715018a6146100d75780638da5cb5b146100df575b5f5ffd5b6100a7610148565b005b6100a76100b73660046106f4565b6101ed565b6100c461045a565b6040519081526020015b60405180910390f35b6100a76104b6565b6002546100f2906001600160a01b031681565b6040516001600160a01b0390911681526020016100ce565b6001546100c4565b6100a7610120366004610772565b6104ee565b6100a761057a565b6100a76105c3565b6100a7610143366004610772565b610626565b5f6001541161019e5760405162461bcd60e51b815260206004820152601860248201527f436f756e742063616e6e6f74206265206e65676174697665000000000000000060448201526064015b60405180910390fd5b6001805f8282546101af91906107a6565b90915550506001546040519081527fc9118d86370931e39644ee137c931308fa3774f6c90ab057f0c3febf427ef94a906020015b60405180910390a1565b306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016148061026a57507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661025e5f5160206107f95f395f51905f525490565b6001600160a01b031614155b156102885760405163703e46dd60e11b815260040160405180910390fd5b6002546001600160a01b031633146102b55760405163118cdaa760e01b8152336004820152602401610195565b826001600160a01b03163b5f036102ea57604051634c9c8ce360e01b81526001600160a01b0384166004820152602401610195565b826001600160a01b03166352d1902d6040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015610344575060408051601f3d908101601f19168201909252610341918101906107bf565b60015b61036c57604051634c9c8ce360e01b81526001600160a01b0384166004820152602401610195565b5f5160206107f95f395f51905f52811461039c57604051632a87526960e21b815260048101829052602401610195565b505f5160206107f95f395f51905f528390556040516001600160a01b038416907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b905f90a28015610455575f5f846001600160a01b031684846040516104039291906107d6565b5f60405180830381855af49150503d805f811461043b576040519150601f19603f3d011682016040523d82523d5f602084013e610440565b606091505b50915091508161045257805160208201fd5b50505b505050565b5f306001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146104a45760405163703e46dd60e11b815260040160405180910390fd5b505f5160206107f95f395f51905f5290565b6002546001600160a01b031633146104e35760405163118cdaa760e01b8152336004820152602401610195565b6104ec5f610688565b565b5f5460ff16156105115760405163f92ee8a960e01b815260040160405180910390fd5b6001600160a01b03811661053a57604051631e4fbdf760e01b81525f6004820152602401610195565b5f805460ff1916600117905561054f81610688565b6040517f5daa87a0e9463431830481fd4b6e3403442dfb9a12b9c07597e9f61d50b633c8905f90a150565b6001805f82825461058b91906107e5565b90915550506001546040519081527f20d8a6f5a693f9d1d627a598e8820f7a55ee74c183aa8f1a30e8d4e8dd9a8d84906020016101e3565b6002546001600160a01b031633146105f05760405163118cdaa760e01b8152336004820152602401610195565b5f60018190556040519081527f01c3cbb0d62726ab09d163873ebf9aed99dd8dc08e57bc938f458132fd178cf6906020016101e3565b6002546001600160a01b031633146106535760405163118cdaa760e01b8152336004820152602401610195565b6001600160a01b03811661067c57604051631e4fbdf760e01b81525f6004820152602401610195565b61068581610688565b50565b600280546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0905f90a35050565b80356001600160a01b03811681146106ef575f5ffd5b919050565b5f5f5f60408486031215610706575f5ffd5b61070f846106d9565b9250602084013567ffffffffffffffff81111561072a575f5ffd5b8401601f8101861361073a575f5ffd5b803567ffffffffffffffff811115610750575f5ffd5b866020828401011115610761575f5ffd5b939660209190910195509293505050565b5f60208284031215610782575f5ffd5b61078b826106d9565b9392505050565b634e487b7160e01b5f52601160045260245ffd5b818103818111156107b9576107b9610792565b92915050565b5f602082840312156107cf575f5ffd5b5051919050565b818382375f9101908152919050565b808201808211156107b9576107b961079256fe360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbca2646970667358221220be513d548125b030847a6fc5c4db427093ca3b926dea350ba07bc669ce57e86664736f6c634300081e0033"),
+	Immutables: []bytecode.Range{
+		{Start: 504, Length: 32},
+		{Start: 545, Length: 32},
+		{Start: 1126, Length: 32},
+	},
+}
@@ -0,0 +1,5 @@
+package upgradeable
+
+// counter.go、proxy.go 和对应的 *_runtime.go 由 contracts/ 下的源码生成，修改合约后运行 go generate ./upgradeable
+//go:generate go run ../cmd/contractgen -src ../contracts/CounterUpgradeable.sol -contract CounterUpgradeable -build ../build -pkg upgradeable -out counter.go -runtime counter_runtime.go
+//go:generate go run ../cmd/contractgen -src ../contracts/ERC1967Proxy.sol -contract ERC1967Proxy -build ../build -pkg upgradeable -out proxy.go -runtime proxy_runtime.go
@@ -0,0 +1,34 @@
+package upgradeable
+
+import (
+	"testing"
+
+	"github.com/clc781032855/go_ethereum/internal/gentest"
+)
+
+// 保证生成的绑定、build/ 和 contracts/ 下的源码三者一致。
+// 失败时运行 go generate ./upgradeable 重新生成。
+func TestGenerated(t *testing.T) {
+	for _, c := range []gentest.Contract{
+		{
+			Name:     "CounterUpgradeable",
+			Source:   "../contracts/CounterUpgradeable.sol",
+			BuildDir: "../build",
+			Binding:  "counter.go",
+			Pkg:      "upgradeable",
+			Meta:     CounterUpgradeableMetaData,
+			Runtime:  CounterUpgradeableRuntime,
+		},
+		{
+			Name:     "ERC1967Proxy",
+			Source:   "../contracts/ERC1967Proxy.sol",
+			BuildDir: "../build",
+			Binding:  "proxy.go",
+			Pkg:      "upgradeable",
+			Meta:     ERC1967ProxyMetaData,
+			Runtime:  ERC1967ProxyRuntime,
+		},
+	} {
+		t.Run(c.Name, func(t *testing.T) { gentest.Check(t, c) })
+	}
+}
@@ -0,0 +1,15 @@
+// Code generated by contractgen - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package upgradeable
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/clc781032855/go_ethereum/bytecode"
+)
+
+// ERC1967ProxyRuntime 是 ERC1967Proxy 部署后的运行时字节码，用于校验链上代码。
+var ERC1967ProxyRuntime = &bytecode.Runtime{
+	Code: common.FromHex("0x60806040527f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc54365f5f375f5f365f845af490503d5f5f3e8080156041573d5ff35b3d5ffdfea264697066735822122052d5beecb1ba01bf1770ca57d73e35d5df595e9e262a2f837290b4c5fbdf605264736f6c634300081e0033"),
+}
@@ -0,0 +1,54 @@
+// Package upgradeable 是部署在 ERC-1967 代理后面、可升级（UUPS）的 Counter。
+//
+// 与代理交互使用实现合约的 ABI：NewCounterUpgradeable(代理地址, backend)。
+// 实现合约本身被锁定，不能初始化，也不能直接升级。
+package upgradeable
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ImplementationSlot 是 ERC-1967 实现槽：keccak256("eip1967.proxy.implementation") - 1
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// InitializeData 返回 initialize(initialOwner) 的调用数据，部署代理时传给构造函数。
+func InitializeData(initialOwner common.Address) ([]byte, error) {
+	parsed, err := CounterUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("initialize", initialOwner)
+}
+
+// DeployCounterProxy 部署指向 implementation 的代理，并在同一笔交易中以 initialOwner 初始化，
+// 返回绑定到代理地址的 CounterUpgradeable。
+func DeployCounterProxy(auth *bind.TransactOpts, backend bind.ContractBackend, implementation, initialOwner common.Address) (common.Address, *types.Transaction, *CounterUpgradeable, error) {
+	data, err := InitializeData(initialOwner)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, _, err := DeployERC1967Proxy(auth, backend, implementation, data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewCounterUpgradeable(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+// Implementation 读取代理的 ERC-1967 实现槽，blockNumber 为 nil 时读取最新区块。
+func Implementation(ctx context.Context, reader ethereum.ChainStateReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	slot, err := reader.StorageAt(ctx, proxy, ImplementationSlot, blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(slot), nil
+}
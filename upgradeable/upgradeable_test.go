@@ -0,0 +1,225 @@
+package upgradeable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/clc781032855/go_ethereum/internal/simtest"
+	"github.com/clc781032855/go_ethereum/revert"
+)
+
+// fixture 是模拟链上的实现合约和指向它的代理
+type fixture struct {
+	sim            *simulated.Backend
+	auth           *bind.TransactOpts // 部署者，也是代理的所有者
+	other          *bind.TransactOpts
+	implementation common.Address
+	proxy          common.Address
+	counter        *CounterUpgradeable // 绑定到代理地址
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	auth, other := simtest.NewAccount(t), simtest.NewAccount(t)
+	sim := simtest.NewBackend(t, nil, auth.From, other.From)
+
+	f := &fixture{sim: sim, auth: auth, other: other}
+	f.implementation = f.deployImplementation(t)
+	proxy, tx, c, err := DeployCounterProxy(auth, sim.Client(), f.implementation, auth.From)
+	if err != nil {
+		t.Fatalf("DeployCounterProxy: %v", err)
+	}
+	simtest.Mine(t, sim, tx)
+	f.proxy, f.counter = proxy, c
+	return f
+}
+
+func (f *fixture) deployImplementation(t *testing.T) common.Address {
+	t.Helper()
+	address, tx, _, err := DeployCounterUpgradeable(f.auth, f.sim.Client())
+	if err != nil {
+		t.Fatalf("DeployCounterUpgradeable: %v", err)
+	}
+	simtest.Mine(t, f.sim, tx)
+	return address
+}
+
+// transact 以 auth 的身份发送交易并出块。
+func (f *fixture) transact(t *testing.T, auth *bind.TransactOpts, fn func(*bind.TransactOpts) (*types.Transaction, error)) {
+	t.Helper()
+	simtest.Transact(t, f.sim, auth, fn)
+}
+
+// expectRevert 确认 err 是名为 name 的自定义错误。交易在估算 gas 时就会失败。
+func expectRevert(t *testing.T, err error, name string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("call succeeded; want revert %s", name)
+	}
+	parsed, abiErr := CounterUpgradeableMetaData.GetAbi()
+	if abiErr != nil {
+		t.Fatal(abiErr)
+	}
+	reason, ok := revert.FromError(err, parsed)
+	if !ok || reason.Name != name {
+		t.Fatalf("err = %v; want revert %s", err, name)
+	}
+}
+
+func (f *fixture) implementationSlot(t *testing.T) common.Address {
+	t.Helper()
+	impl, err := Implementation(context.Background(), f.sim.Client(), f.proxy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return impl
+}
+
+func (f *fixture) count(t *testing.T) int64 {
+	t.Helper()
+	n, err := f.counter.GetCount(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n.Int64()
+}
+
+func TestDeployProxy(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+	if got := f.implementationSlot(t); got != f.implementation {
+		t.Errorf("implementation slot = %s; want %s", got.Hex(), f.implementation.Hex())
+	}
+	owner, err := f.counter.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != f.auth.From {
+		t.Errorf("owner = %s; want %s", owner.Hex(), f.auth.From.Hex())
+	}
+
+	// immutable __self 在部署时写入，校验时必须忽略
+	if err := CounterUpgradeableRuntime.Verify(ctx, f.sim.Client(), f.implementation); err != nil {
+		t.Errorf("implementation: %v", err)
+	}
+	if err := ERC1967ProxyRuntime.Verify(ctx, f.sim.Client(), f.proxy); err != nil {
+		t.Errorf("proxy: %v", err)
+	}
+
+	f.transact(t, f.other, f.counter.Increment)
+	if got := f.count(t); got != 1 {
+		t.Errorf("count = %d; want 1", got)
+	}
+}
+
+func TestInitializeOnce(t *testing.T) {
+	f := newFixture(t)
+	_, err := f.counter.Initialize(f.other, f.other.From)
+	expectRevert(t, err, "InvalidInitialization")
+
+	// 实现合约在构造时已锁定
+	impl, err := NewCounterUpgradeable(f.implementation, f.sim.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = impl.Initialize(f.other, f.other.From)
+	expectRevert(t, err, "InvalidInitialization")
+}
+
+func TestUpgradeKeepsState(t *testing.T) {
+	f := newFixture(t)
+	f.transact(t, f.auth, f.counter.Increment)
+	f.transact(t, f.auth, f.counter.Increment)
+
+	next := f.deployImplementation(t)
+	f.transact(t, f.auth, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return f.counter.UpgradeToAndCall(auth, next, nil)
+	})
+	if got := f.implementationSlot(t); got != next {
+		t.Fatalf("implementation slot = %s; want %s", got.Hex(), next.Hex())
+	}
+	if got := f.count(t); got != 2 {
+		t.Errorf("count after upgrade = %d; want 2", got)
+	}
+	owner, err := f.counter.Owner(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner != f.auth.From {
+		t.Errorf("owner after upgrade = %s; want %s", owner.Hex(), f.auth.From.Hex())
+	}
+
+	iter, err := f.counter.FilterUpgraded(&bind.FilterOpts{Start: 0}, []common.Address{next})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iter.Close()
+	if !iter.Next() {
+		t.Error("no Upgraded event for the new implementation")
+	}
+}
+
+func TestUpgradeWithCall(t *testing.T) {
+	f := newFixture(t)
+	next := f.deployImplementation(t)
+	parsed, err := CounterUpgradeableMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Pack("increment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.transact(t, f.auth, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return f.counter.UpgradeToAndCall(auth, next, data)
+	})
+	if got := f.count(t); got != 1 {
+		t.Errorf("count after upgrade call = %d; want 1", got)
+	}
+}
+
+func TestUpgradeRejected(t *testing.T) {
+	f := newFixture(t)
+	next := f.deployImplementation(t)
+
+	_, err := f.counter.UpgradeToAndCall(f.other, next, nil)
+	expectRevert(t, err, "OwnableUnauthorizedAccount")
+
+	// 不支持 UUPS 的目标：普通账户和代理本身
+	_, err = f.counter.UpgradeToAndCall(f.auth, f.other.From, nil)
+	expectRevert(t, err, "ERC1967InvalidImplementation")
+	_, err = f.counter.UpgradeToAndCall(f.auth, f.proxy, nil)
+	expectRevert(t, err, "ERC1967InvalidImplementation")
+
+	// 直接调用实现合约
+	impl, err := NewCounterUpgradeable(f.implementation, f.sim.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = impl.UpgradeToAndCall(f.auth, next, nil)
+	expectRevert(t, err, "UUPSUnauthorizedCallContext")
+
+	if got := f.implementationSlot(t); got != f.implementation {
+		t.Errorf("implementation slot changed to %s", got.Hex())
+	}
+}
+
+func TestProxyRejectsEmptyImplementation(t *testing.T) {
+	f := newFixture(t)
+	_, _, _, err := DeployCounterProxy(f.auth, f.sim.Client(), f.other.From, f.auth.From)
+	if err == nil {
+		t.Fatal("deploying a proxy to an account without code succeeded")
+	}
+	parsed, err2 := ERC1967ProxyMetaData.GetAbi()
+	if err2 != nil {
+		t.Fatal(err2)
+	}
+	if reason, ok := revert.FromError(err, parsed); !ok || reason.Name != "ERC1967InvalidImplementation" {
+		t.Errorf("err = %v; want ERC1967InvalidImplementation", err)
+	}
+}